@@ -0,0 +1,221 @@
+package connectivity
+
+import (
+	"goraph/graph"
+	dg "goraph/graph/digraph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"slices"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set"
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// Cuts holds single points of failure of a graph.
+type Cuts[V graph.Vertex] struct {
+	// ArticulationPoints holds vertices which removal increases
+	// the amount of components.
+	ArticulationPoints set.Set[V]
+
+	// Bridges holds edges which removal increases the amount of components.
+	Bridges set.Set[graph.Edge[V]]
+}
+
+// Blocks holds biconnected components (blocks) of the underlying undirected
+// graph with its articulation points and bridges.
+type Blocks[V graph.Vertex] struct {
+	Cuts[V]
+
+	// Blocks holds vertices of every block. Blocks of a bridge have 2
+	// vertices and an isolated vertex forms a block alone.
+	Blocks [][]V
+}
+
+// BlockCutVertex is a vertex of the block-cut tree: either a block
+// identified by its index in Blocks.Blocks or an articulation point.
+type BlockCutVertex[V graph.Vertex] struct {
+	Block       int
+	CutVertex   V
+	IsCutVertex bool
+}
+
+// Biconnectivity computes Blocks of the underlying undirected graph of
+// digraph as described in package digraph, so (u, v) and (v, u) are
+// a single undirected edge. Blocks.Bridges holds all edges of digraph that
+// correspond to bridges, so both of them if the undirected edge is a bridge.
+//
+// It's Hopcroft-Tarjan algorithm, which uses an explicit stack, so it
+// doesn't overflow the goroutine stack on deep graphs.
+//
+// It panics if digraph is nil.
+//
+// Time complexity: O(V + E log E).
+//
+// https://en.wikipedia.org/wiki/Biconnected_component
+func Biconnectivity[V graph.Vertex](digraph dg.Digraph[V]) *Blocks[V] {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+
+	indexedDigraph := dg.NewIndexedDigraph(digraph)
+	indexer := indexedDigraph.Indexer()
+	n := indexedDigraph.Order()
+
+	neighbors := make([][]int, n)
+
+	for u := range neighbors {
+		neighbors[u] = slices.Concat(indexedDigraph.Successors(u), indexedDigraph.Predecessors(u))
+		slices.Sort(neighbors[u])
+		neighbors[u] = slices.Compact(neighbors[u])
+	}
+
+	const unvisited = -1
+
+	blocks := &Blocks[V]{
+		Cuts: Cuts[V]{
+			ArticulationPoints: mapset.New[V](),
+			Bridges:            mapset.New[graph.Edge[V]](),
+		},
+		Blocks: make([][]V, 0),
+	}
+	discovery := make([]int, n)
+	low := make([]int, n)
+	parent := make([]int, n)
+	stack := make([]int, 0)
+	callStack := make([]dfsFrame, 0)
+	nextDiscovery := 0
+
+	for i := range discovery {
+		discovery[i] = unvisited
+	}
+
+	visit := func(u, parentOfU int) {
+		discovery[u] = nextDiscovery
+		low[u] = nextDiscovery
+		parent[u] = parentOfU
+		nextDiscovery++
+
+		stack = append(stack, u)
+		callStack = append(callStack, dfsFrame{u: u})
+	}
+
+	for root := 0; root < n; root++ {
+		if discovery[root] != unvisited {
+			continue
+		}
+
+		amountOfRootChildren := 0
+
+		visit(root, unvisited)
+
+		for len(callStack) > 0 {
+			frame := &callStack[len(callStack)-1]
+			u := frame.u
+
+			if frame.nextSuccessor < len(neighbors[u]) {
+				w := neighbors[u][frame.nextSuccessor]
+				frame.nextSuccessor++
+
+				switch {
+				case w == parent[u]:
+
+				case discovery[w] == unvisited:
+					if u == root {
+						amountOfRootChildren++
+					}
+
+					visit(w, u)
+
+				default:
+					low[u] = min(low[u], discovery[w])
+				}
+
+				continue
+			}
+
+			callStack = callStack[:len(callStack)-1]
+			p := parent[u]
+
+			if p == unvisited {
+				continue
+			}
+
+			low[p] = min(low[p], low[u])
+
+			if low[u] > discovery[p] {
+				for _, edge := range [...][2]int{{p, u}, {u, p}} {
+					if indexedDigraph.HasEdge(edge[0], edge[1]) {
+						blocks.Bridges.Add(graph.NewEdge(indexer.Vertex(edge[0]), indexer.Vertex(edge[1])))
+					}
+				}
+			}
+
+			if low[u] >= discovery[p] {
+				if p != root {
+					blocks.ArticulationPoints.Add(indexer.Vertex(p))
+				}
+
+				block := []V{indexer.Vertex(p)}
+
+				for {
+					v := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					block = append(block, indexer.Vertex(v))
+
+					if v == u {
+						break
+					}
+				}
+
+				blocks.Blocks = append(blocks.Blocks, block)
+			}
+		}
+
+		if amountOfRootChildren == 0 {
+			blocks.Blocks = append(blocks.Blocks, []V{indexer.Vertex(root)})
+		}
+		if amountOfRootChildren >= 2 {
+			blocks.ArticulationPoints.Add(indexer.Vertex(root))
+		}
+
+		stack = stack[:0]
+	}
+
+	return blocks
+}
+
+// BlockCutTree creates the block-cut tree of blocks: a forest with
+// a vertex for every block and every articulation point, in which
+// an articulation point is adjacent to every block that contains it.
+//
+// Every undirected edge of the forest is represented by two opposite edges.
+func (blocks *Blocks[V]) BlockCutTree() simpledigraph.SimpleDigraph[BlockCutVertex[V]] {
+	vertices := mapset.New[BlockCutVertex[V]]()
+	edges := mapset.New[graph.Edge[BlockCutVertex[V]]]()
+
+	for _, cutVertex := range blocks.ArticulationPoints.Elements() {
+		vertices.Add(BlockCutVertex[V]{CutVertex: cutVertex, IsCutVertex: true})
+	}
+
+	for i, block := range blocks.Blocks {
+		blockVertex := BlockCutVertex[V]{Block: i}
+		vertices.Add(blockVertex)
+
+		for _, vertex := range block {
+			if blocks.ArticulationPoints.Contains(vertex) {
+				cutVertex := BlockCutVertex[V]{CutVertex: vertex, IsCutVertex: true}
+
+				edges.Add(graph.NewEdge(blockVertex, cutVertex))
+				edges.Add(graph.NewEdge(cutVertex, blockVertex))
+			}
+		}
+	}
+
+	blockCutTree, err := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return blockCutTree
+}
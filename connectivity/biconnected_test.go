@@ -0,0 +1,154 @@
+package connectivity
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/graph/generate"
+	"slices"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set"
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+func verticesExcept(digraph digraph.Digraph[int], vertex int) set.Set[int] {
+	vertices := mapset.New[int]()
+
+	for other := range digraph.AllVertices() {
+		if other != vertex {
+			vertices.Add(other)
+		}
+	}
+
+	return vertices
+}
+
+func TestBiconnectivity(t *testing.T) {
+	// triangle 1-2-3, bridge 3-4, triangle 4-5-6 with both directions
+	// of 4-5, isolated 7
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4, 5, 6, 7),
+		mapset.NewFromElements(
+			graph.NewEdge(1, 2),
+			graph.NewEdge(2, 3),
+			graph.NewEdge(1, 3),
+			graph.NewEdge(4, 3),
+			graph.NewEdge(4, 5),
+			graph.NewEdge(5, 4),
+			graph.NewEdge(5, 6),
+			graph.NewEdge(6, 4),
+		),
+	)
+
+	blocks := Biconnectivity(simpleDigraph)
+
+	assert.ElementsMatch(t, []int{3, 4}, blocks.ArticulationPoints.Elements())
+	assert.ElementsMatch(t, []graph.Edge[int]{graph.NewEdge(4, 3)}, blocks.Bridges.Elements())
+	assert.Len(t, blocks.Blocks, 4)
+
+	sortedBlocks := make([][]int, 0)
+
+	for _, block := range blocks.Blocks {
+		sortedBlocks = append(sortedBlocks, slices.Sorted(slices.Values(block)))
+	}
+
+	assert.ElementsMatch(t, [][]int{{1, 2, 3}, {3, 4}, {4, 5, 6}, {7}}, sortedBlocks)
+
+	blockCutTree := blocks.BlockCutTree()
+
+	assert.Equal(t, 6, blockCutTree.Order())
+	assert.Equal(t, 8, blockCutTree.Size())
+	assert.Equal(t, 2, blockCutTree.OutDegree(BlockCutVertex[int]{CutVertex: 3, IsCutVertex: true}))
+	assert.Equal(t, 2, blockCutTree.OutDegree(BlockCutVertex[int]{CutVertex: 4, IsCutVertex: true}))
+}
+
+func TestBiconnectivity_BothDirections(t *testing.T) {
+	// path 1-2-3 with both directions of every undirected edge
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3),
+		mapset.NewFromElements(
+			graph.NewEdge(1, 2),
+			graph.NewEdge(2, 1),
+			graph.NewEdge(2, 3),
+			graph.NewEdge(3, 2),
+		),
+	)
+
+	blocks := Biconnectivity(simpleDigraph)
+
+	assert.ElementsMatch(t, []int{2}, blocks.ArticulationPoints.Elements())
+	assert.ElementsMatch(
+		t,
+		[]graph.Edge[int]{graph.NewEdge(1, 2), graph.NewEdge(2, 1), graph.NewEdge(2, 3), graph.NewEdge(3, 2)},
+		blocks.Bridges.Elements(),
+	)
+	assert.Len(t, blocks.Blocks, 2)
+}
+
+func TestBiconnectivity_LongPath(t *testing.T) {
+	amountOfVertices := 200_000
+	vertices := mapset.New[int]()
+	edges := mapset.New[graph.Edge[int]]()
+
+	for vertex := 0; vertex < amountOfVertices; vertex++ {
+		vertices.Add(vertex)
+
+		if vertex > 0 {
+			edges.Add(graph.NewEdge(vertex-1, vertex))
+		}
+	}
+
+	path, _ := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+	blocks := Biconnectivity(path)
+
+	assert.Equal(t, amountOfVertices-2, blocks.ArticulationPoints.Size())
+	assert.Equal(t, amountOfVertices-1, blocks.Bridges.Size())
+	assert.Len(t, blocks.Blocks, amountOfVertices-1)
+}
+
+func TestBiconnectivity_Random(t *testing.T) {
+	rng := generate.NewRand(1)
+
+	for i := range 50 {
+		simpleDigraph, err := generate.ErdosRenyiGnp(20, 0.03+float64(i%3)*0.03, rng)
+
+		assert.Nil(t, err)
+
+		blocks := Biconnectivity(simpleDigraph)
+		amountOfComponents := WeaklyConnectedComponents(simpleDigraph).Count()
+
+		for vertex := range simpleDigraph.AllVertices() {
+			rest := verticesExcept(simpleDigraph, vertex)
+
+			isArticulationPoint := WeaklyConnectedComponents(digraph.InducedSubgraph(simpleDigraph, rest)).Count() >
+				amountOfComponents
+
+			assert.Equal(t, isArticulationPoint, blocks.ArticulationPoints.Contains(vertex))
+		}
+
+		for edge := range simpleDigraph.AllEdges() {
+			withoutEdge := digraph.FilterEdges(simpleDigraph, func(other graph.Edge[int]) bool {
+				return other != edge && other != graph.NewEdge(edge.Target(), edge.Source())
+			})
+
+			isBridge := WeaklyConnectedComponents(withoutEdge).Count() > amountOfComponents
+
+			assert.Equal(t, isBridge, blocks.Bridges.Contains(edge))
+		}
+
+		// every edge is in exactly one block
+		for edge := range simpleDigraph.AllEdges() {
+			amountOfBlocks := 0
+
+			for _, block := range blocks.Blocks {
+				if slices.Contains(block, edge.Source()) && slices.Contains(block, edge.Target()) {
+					amountOfBlocks++
+				}
+			}
+
+			assert.Equal(t, 1, amountOfBlocks)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Package connectivity provides strongly and weakly connected components
+// of digraph.Digraph, condensation and union-find.
+package connectivity
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// Components is a partition of vertices into components, every component
+// is identified by its index (ID) in Components.
+type Components[V graph.Vertex] struct {
+	// Components holds vertices of every component.
+	Components [][]V
+
+	// VertexToComponent maps every vertex to the ID of its component.
+	VertexToComponent map[V]int
+}
+
+// newComponents creates Components from componentOfIndex that maps
+// indexer index of every vertex to its component ID.
+func newComponents[V graph.Vertex](
+	indexer *graph.Indexer[V],
+	componentOfIndex []int,
+	amountOfComponents int,
+) *Components[V] {
+	components := &Components[V]{
+		Components:        make([][]V, amountOfComponents),
+		VertexToComponent: make(map[V]int, indexer.Len()),
+	}
+
+	for i, component := range componentOfIndex {
+		vertex := indexer.Vertex(i)
+
+		components.Components[component] = append(components.Components[component], vertex)
+		components.VertexToComponent[vertex] = component
+	}
+
+	return components
+}
+
+// Count returns the amount of components.
+func (components *Components[V]) Count() int {
+	return len(components.Components)
+}
+
+// Component returns vertices of the component of vertex or nil
+// if vertex is not present.
+func (components *Components[V]) Component(vertex V) []V {
+	component, isPresent := components.VertexToComponent[vertex]
+
+	if !isPresent {
+		return nil
+	}
+
+	return components.Components[component]
+}
+
+// Condensation creates an immutable simpledigraph.SimpleDigraph using
+// adjacency list ADT which vertices are component IDs of components and
+// which has edge (a, b) iff digraph has an edge from a vertex of
+// component a to a vertex of component b != a.
+//
+// Condensation of strongly connected components is a DAG.
+//
+// https://en.wikipedia.org/wiki/Strongly_connected_component#Definitions
+func Condensation[V graph.Vertex](
+	digraph digraph.Digraph[V],
+	components *Components[V],
+) simpledigraph.SimpleDigraph[int] {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+	if components == nil {
+		panic("components == nil")
+	}
+
+	vertices := mapset.New[int]()
+	edges := mapset.New[graph.Edge[int]]()
+
+	for component := range components.Components {
+		vertices.Add(component)
+	}
+
+	for edge := range digraph.AllEdges() {
+		a := components.VertexToComponent[edge.Source()]
+		b := components.VertexToComponent[edge.Target()]
+
+		if a != b {
+			edges.Add(graph.NewEdge(a, b))
+		}
+	}
+
+	condensation, err := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return condensation
+}
@@ -0,0 +1,227 @@
+package connectivity
+
+import (
+	"goraph/graph"
+	dg "goraph/graph/digraph"
+	"slices"
+)
+
+// StronglyConnectedComponents interface represents an algorithm with single
+// method that computes strongly connected components of digraph.Digraph.
+//
+// https://en.wikipedia.org/wiki/Strongly_connected_component
+type StronglyConnectedComponents[V graph.Vertex] interface {
+	// Compute computes strongly connected Components of digraph.
+	//
+	// Component IDs are a topological order of the Condensation,
+	// so every edge between components goes from a lesser ID to a greater one.
+	//
+	// It panics if digraph is nil.
+	Compute(digraph dg.Digraph[V]) *Components[V]
+}
+
+type tarjan[V graph.Vertex] struct{}
+
+var _ StronglyConnectedComponents[struct{}] = (*tarjan[struct{}])(nil)
+
+// NewTarjan creates a Tarjan's algorithm implementation of
+// StronglyConnectedComponents, which needs a single depth-first search.
+//
+// The search uses an explicit stack, so it doesn't overflow the goroutine
+// stack on deep graphs.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Tarjan%27s_strongly_connected_components_algorithm
+func NewTarjan[V graph.Vertex]() StronglyConnectedComponents[V] {
+	return tarjan[V]{}
+}
+
+// dfsFrame is a vertex on the depth-first search stack with the position
+// of its next successor to examine.
+type dfsFrame struct {
+	u             int
+	nextSuccessor int
+}
+
+func (algorithm tarjan[V]) Compute(digraph dg.Digraph[V]) *Components[V] {
+	indexedDigraph := dg.NewIndexedDigraph(digraph)
+	n := indexedDigraph.Order()
+
+	const unvisited = -1
+
+	index := make([]int, n)
+	lowLink := make([]int, n)
+	isOnStack := make([]bool, n)
+	componentOfIndex := make([]int, n)
+	stack := make([]int, 0)
+	callStack := make([]dfsFrame, 0)
+	nextIndex := 0
+	amountOfComponents := 0
+
+	for i := range index {
+		index[i] = unvisited
+	}
+
+	visit := func(u int) {
+		index[u] = nextIndex
+		lowLink[u] = nextIndex
+		nextIndex++
+
+		stack = append(stack, u)
+		isOnStack[u] = true
+		callStack = append(callStack, dfsFrame{u: u})
+	}
+
+	for s := 0; s < n; s++ {
+		if index[s] != unvisited {
+			continue
+		}
+
+		visit(s)
+
+		for len(callStack) > 0 {
+			frame := &callStack[len(callStack)-1]
+			u := frame.u
+			successors := indexedDigraph.Successors(u)
+
+			if frame.nextSuccessor < len(successors) {
+				v := successors[frame.nextSuccessor]
+				frame.nextSuccessor++
+
+				if index[v] == unvisited {
+					visit(v)
+				} else if isOnStack[v] {
+					lowLink[u] = min(lowLink[u], index[v])
+				}
+
+				continue
+			}
+
+			callStack = callStack[:len(callStack)-1]
+
+			// u is the root of a component
+			if lowLink[u] == index[u] {
+				for {
+					v := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					isOnStack[v] = false
+					componentOfIndex[v] = amountOfComponents
+
+					if v == u {
+						break
+					}
+				}
+
+				amountOfComponents++
+			}
+
+			if len(callStack) > 0 {
+				parent := callStack[len(callStack)-1].u
+				lowLink[parent] = min(lowLink[parent], lowLink[u])
+			}
+		}
+	}
+
+	// Tarjan's algorithm finds components in reverse topological order
+	for i := range componentOfIndex {
+		componentOfIndex[i] = amountOfComponents - 1 - componentOfIndex[i]
+	}
+
+	return newComponents(indexedDigraph.Indexer(), componentOfIndex, amountOfComponents)
+}
+
+type kosaraju[V graph.Vertex] struct{}
+
+var _ StronglyConnectedComponents[struct{}] = (*kosaraju[struct{}])(nil)
+
+// NewKosaraju creates a Kosaraju's algorithm implementation of
+// StronglyConnectedComponents, which needs a depth-first search of digraph
+// and a search of its reverse.
+//
+// Both searches use explicit stacks, so they don't overflow the goroutine
+// stack on deep graphs.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Kosaraju%27s_algorithm
+func NewKosaraju[V graph.Vertex]() StronglyConnectedComponents[V] {
+	return kosaraju[V]{}
+}
+
+func (algorithm kosaraju[V]) Compute(digraph dg.Digraph[V]) *Components[V] {
+	indexedDigraph := dg.NewIndexedDigraph(digraph)
+	n := indexedDigraph.Order()
+
+	// first search computes postorder of digraph
+
+	isVisited := make([]bool, n)
+	postorder := make([]int, 0, n)
+	callStack := make([]dfsFrame, 0)
+
+	for s := 0; s < n; s++ {
+		if isVisited[s] {
+			continue
+		}
+
+		isVisited[s] = true
+		callStack = append(callStack, dfsFrame{u: s})
+
+		for len(callStack) > 0 {
+			frame := &callStack[len(callStack)-1]
+			successors := indexedDigraph.Successors(frame.u)
+
+			if frame.nextSuccessor < len(successors) {
+				v := successors[frame.nextSuccessor]
+				frame.nextSuccessor++
+
+				if !isVisited[v] {
+					isVisited[v] = true
+					callStack = append(callStack, dfsFrame{u: v})
+				}
+
+				continue
+			}
+
+			postorder = append(postorder, frame.u)
+			callStack = callStack[:len(callStack)-1]
+		}
+	}
+
+	// second search collects components in reverse digraph
+	// in reverse postorder, which is topological order of components
+
+	const unassigned = -1
+
+	componentOfIndex := make([]int, n)
+	amountOfComponents := 0
+
+	for i := range componentOfIndex {
+		componentOfIndex[i] = unassigned
+	}
+
+	for _, s := range slices.Backward(postorder) {
+		if componentOfIndex[s] != unassigned {
+			continue
+		}
+
+		stack := []int{s}
+		componentOfIndex[s] = amountOfComponents
+
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			for _, u := range indexedDigraph.Predecessors(v) {
+				if componentOfIndex[u] == unassigned {
+					componentOfIndex[u] = amountOfComponents
+					stack = append(stack, u)
+				}
+			}
+		}
+
+		amountOfComponents++
+	}
+
+	return newComponents(indexedDigraph.Indexer(), componentOfIndex, amountOfComponents)
+}
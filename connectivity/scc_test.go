@@ -0,0 +1,102 @@
+package connectivity
+
+import (
+	"goraph/graph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/graph/generate"
+	"slices"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+var algorithms = map[string]StronglyConnectedComponents[int]{
+	"Tarjan":   NewTarjan[int](),
+	"Kosaraju": NewKosaraju[int](),
+}
+
+func TestStronglyConnectedComponents_Compute(t *testing.T) {
+	// {1, 2, 3} -> {4, 5} -> {6}, {7}
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4, 5, 6, 7),
+		mapset.NewFromElements(
+			graph.NewEdge(1, 2),
+			graph.NewEdge(2, 3),
+			graph.NewEdge(3, 1),
+			graph.NewEdge(3, 4),
+			graph.NewEdge(4, 5),
+			graph.NewEdge(5, 4),
+			graph.NewEdge(5, 6),
+			graph.NewEdge(2, 5),
+		),
+	)
+
+	for name, algorithm := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			components := algorithm.Compute(simpleDigraph)
+
+			assert.Equal(t, 4, components.Count())
+			assert.ElementsMatch(t, []int{1, 2, 3}, components.Component(2))
+			assert.ElementsMatch(t, []int{4, 5}, components.Component(4))
+			assert.Equal(t, []int{6}, components.Component(6))
+			assert.Equal(t, []int{7}, components.Component(7))
+			assert.Nil(t, components.Component(8))
+
+			condensation := Condensation(simpleDigraph, components)
+
+			assert.Equal(t, 4, condensation.Order())
+			assert.Equal(t, 2, condensation.Size())
+			assert.NotNil(t, condensation.Edge(components.VertexToComponent[1], components.VertexToComponent[4]))
+			assert.NotNil(t, condensation.Edge(components.VertexToComponent[4], components.VertexToComponent[6]))
+		})
+	}
+}
+
+func TestStronglyConnectedComponents_Compute_Random(t *testing.T) {
+	rng := generate.NewRand(1)
+
+	for range 20 {
+		simpleDigraph, err := generate.ErdosRenyiGnp(40, 0.04, rng)
+
+		assert.Nil(t, err)
+
+		tarjanComponents := NewTarjan[int]().Compute(simpleDigraph)
+		kosarajuComponents := NewKosaraju[int]().Compute(simpleDigraph)
+
+		assert.Equal(t, tarjanComponents.Count(), kosarajuComponents.Count())
+
+		for _, components := range []*Components[int]{tarjanComponents, kosarajuComponents} {
+			for vertex := range simpleDigraph.AllVertices() {
+				expected := slices.Sorted(slices.Values(tarjanComponents.Component(vertex)))
+				actual := slices.Sorted(slices.Values(components.Component(vertex)))
+
+				assert.Equal(t, expected, actual)
+			}
+
+			// component IDs are a topological order of the condensation
+			for edge := range Condensation(simpleDigraph, components).AllEdges() {
+				assert.Less(t, edge.Source(), edge.Target())
+			}
+		}
+	}
+}
+
+func TestStronglyConnectedComponents_Compute_LongCycle(t *testing.T) {
+	amountOfVertices := 200_000
+	vertices := mapset.New[int]()
+	edges := mapset.New[graph.Edge[int]]()
+
+	for vertex := 1; vertex <= amountOfVertices; vertex++ {
+		vertices.Add(vertex)
+		edges.Add(graph.NewEdge(vertex, vertex%amountOfVertices+1))
+	}
+
+	cycle, _ := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	for name, algorithm := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, 1, algorithm.Compute(cycle).Count())
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package connectivity
+
+import (
+	"goraph/graph"
+	dg "goraph/graph/digraph"
+	"goraph/internal/dominance"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// StrongCuts computes strong articulation points and strong bridges of
+// digraph: vertices and edges which removal increases the amount of strongly
+// connected components.
+//
+// It's the algorithm of Italiano, Laura and Santaroni: in every strongly
+// connected component with a vertex s, vertices other than s are strong
+// articulation points iff they are non-trivial dominators in the flow graph
+// rooted at s or in its reverse, and strong bridges are bridges of these
+// flow graphs. s is checked separately.
+//
+// It panics if digraph is nil.
+//
+// Time complexity: O(V * (V + E)) in the worst case, but close to O(V + E)
+// in practice, since dominators are computed by Cooper-Harvey-Kennedy
+// algorithm.
+//
+// https://doi.org/10.1016/j.tcs.2011.11.011
+func StrongCuts[V graph.Vertex](digraph dg.Digraph[V]) *Cuts[V] {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+
+	indexedDigraph := dg.NewIndexedDigraph(digraph)
+	indexer := indexedDigraph.Indexer()
+	components := NewTarjan[V]().Compute(digraph)
+
+	cuts := &Cuts[V]{
+		ArticulationPoints: mapset.New[V](),
+		Bridges:            mapset.New[graph.Edge[V]](),
+	}
+
+	const notInComponent = -1
+
+	localIndex := make([]int, indexedDigraph.Order())
+
+	for i := range localIndex {
+		localIndex[i] = notInComponent
+	}
+
+	for _, component := range components.Components {
+		if len(component) < 2 {
+			continue
+		}
+
+		globalIndex := make([]int, len(component))
+
+		for i, vertex := range component {
+			globalIndex[i], _ = indexer.Index(vertex)
+			localIndex[globalIndex[i]] = i
+		}
+
+		successors := make([][]int, len(component))
+		predecessors := make([][]int, len(component))
+
+		for u, global := range globalIndex {
+			for _, w := range indexedDigraph.Successors(global) {
+				if v := localIndex[w]; v != notInComponent {
+					successors[u] = append(successors[u], v)
+					predecessors[v] = append(predecessors[v], u)
+				}
+			}
+		}
+
+		const s = 0
+
+		if !isStronglyConnectedWithout(successors, predecessors, s) {
+			cuts.ArticulationPoints.Add(component[s])
+		}
+
+		for _, isReversed := range [...]bool{false, true} {
+			flowSuccessors, flowPredecessors := successors, predecessors
+
+			if isReversed {
+				flowSuccessors, flowPredecessors = predecessors, successors
+			}
+
+			postorder := dominance.Postorder(flowSuccessors, s)
+			dominatorTree := dominance.NewTree(dominance.CooperHarveyKennedy(flowPredecessors, postorder), s)
+
+			for v, u := range dominatorTree.Idom {
+				if v == s {
+					continue
+				}
+
+				if u != s {
+					cuts.ArticulationPoints.Add(component[u])
+				}
+
+				if isBridge(dominatorTree, flowPredecessors, u, v) {
+					if isReversed {
+						u, v = v, u
+					}
+
+					cuts.Bridges.Add(graph.NewEdge(component[u], component[v]))
+				}
+			}
+		}
+
+		for _, global := range globalIndex {
+			localIndex[global] = notInComponent
+		}
+	}
+
+	return cuts
+}
+
+// isStronglyConnectedWithout returns true iff the strongly connected digraph
+// given by successors and predecessors stays strongly connected without s.
+func isStronglyConnectedWithout(successors, predecessors [][]int, s int) bool {
+	start := (s + 1) % len(successors)
+
+	for _, adjacent := range [...][][]int{successors, predecessors} {
+		isReached := make([]bool, len(adjacent))
+		isReached[s] = true
+		isReached[start] = true
+		queue := []int{start}
+		amountOfReached := 1
+
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+
+			for _, v := range adjacent[u] {
+				if !isReached[v] {
+					isReached[v] = true
+					amountOfReached++
+					queue = append(queue, v)
+				}
+			}
+		}
+
+		if amountOfReached < len(adjacent)-1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isBridge returns true iff edge (u, v), where u is the immediate dominator
+// of v in dominatorTree, is on every path from the entry to v: every other
+// edge that enters v comes from a vertex dominated by v.
+func isBridge(dominatorTree *dominance.Tree, predecessors [][]int, u, v int) bool {
+	hasEdge := false
+
+	for _, w := range predecessors[v] {
+		if w == u {
+			hasEdge = true
+		} else if !dominatorTree.Dominates(v, w) {
+			return false
+		}
+	}
+
+	return hasEdge
+}
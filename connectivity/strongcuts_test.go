@@ -0,0 +1,79 @@
+package connectivity
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/graph/generate"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrongCuts(t *testing.T) {
+	// complete {1, 2, 3}, 2-cycle 3-4 and 2-cycle 6-7
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4, 6, 7),
+		mapset.NewFromElements(
+			graph.NewEdge(1, 2),
+			graph.NewEdge(2, 1),
+			graph.NewEdge(2, 3),
+			graph.NewEdge(3, 2),
+			graph.NewEdge(1, 3),
+			graph.NewEdge(3, 1),
+			graph.NewEdge(3, 4),
+			graph.NewEdge(4, 3),
+			graph.NewEdge(4, 6),
+			graph.NewEdge(6, 7),
+			graph.NewEdge(7, 6),
+		),
+	)
+
+	cuts := StrongCuts(simpleDigraph)
+
+	assert.ElementsMatch(t, []int{3}, cuts.ArticulationPoints.Elements())
+	assert.ElementsMatch(
+		t,
+		[]graph.Edge[int]{
+			graph.NewEdge(3, 4),
+			graph.NewEdge(4, 3),
+			graph.NewEdge(6, 7),
+			graph.NewEdge(7, 6),
+		},
+		cuts.Bridges.Elements(),
+	)
+}
+
+func TestStrongCuts_Random(t *testing.T) {
+	rng := generate.NewRand(1)
+	tarjan := NewTarjan[int]()
+
+	for i := range 50 {
+		simpleDigraph, err := generate.ErdosRenyiGnp(15, 0.1+float64(i%3)*0.05, rng)
+
+		assert.Nil(t, err)
+
+		cuts := StrongCuts(simpleDigraph)
+		amountOfComponents := tarjan.Compute(simpleDigraph).Count()
+
+		for vertex := range simpleDigraph.AllVertices() {
+			rest := verticesExcept(simpleDigraph, vertex)
+
+			isArticulationPoint := tarjan.Compute(digraph.InducedSubgraph(simpleDigraph, rest)).Count() >
+				amountOfComponents
+
+			assert.Equal(t, isArticulationPoint, cuts.ArticulationPoints.Contains(vertex))
+		}
+
+		for edge := range simpleDigraph.AllEdges() {
+			withoutEdge := digraph.FilterEdges(simpleDigraph, func(other graph.Edge[int]) bool {
+				return other != edge
+			})
+
+			isBridge := tarjan.Compute(withoutEdge).Count() > amountOfComponents
+
+			assert.Equal(t, isBridge, cuts.Bridges.Contains(edge))
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package connectivity
+
+import "goraph/graph"
+
+// UnionFind is a disjoint-set data structure of vertices with union by size
+// and path halving, so every operation takes almost constant amortized time.
+//
+// This implementation is not thread-safe.
+//
+// https://en.wikipedia.org/wiki/Disjoint-set_data_structure
+type UnionFind[V graph.Vertex] struct {
+	vertexToIndex map[V]int
+	indexToVertex []V
+	parent        []int
+	size          []int
+	count         int
+}
+
+// NewUnionFind creates an empty UnionFind.
+func NewUnionFind[V graph.Vertex]() *UnionFind[V] {
+	return &UnionFind[V]{vertexToIndex: make(map[V]int)}
+}
+
+// Add adds vertex as a singleton set if it is not present.
+func (unionFind *UnionFind[V]) Add(vertex V) {
+	unionFind.indexOf(vertex)
+}
+
+// Find returns the representative of the set of vertex,
+// vertex is added if it is not present.
+func (unionFind *UnionFind[V]) Find(vertex V) V {
+	return unionFind.indexToVertex[unionFind.find(unionFind.indexOf(vertex))]
+}
+
+// Union merges sets of u and v (adding them if they are not present) and
+// returns true iff they were different sets.
+func (unionFind *UnionFind[V]) Union(u, v V) bool {
+	uRoot := unionFind.find(unionFind.indexOf(u))
+	vRoot := unionFind.find(unionFind.indexOf(v))
+
+	if uRoot == vRoot {
+		return false
+	}
+
+	if unionFind.size[uRoot] < unionFind.size[vRoot] {
+		uRoot, vRoot = vRoot, uRoot
+	}
+
+	unionFind.parent[vRoot] = uRoot
+	unionFind.size[uRoot] += unionFind.size[vRoot]
+	unionFind.count--
+
+	return true
+}
+
+// Connected returns true iff u and v are present and in the same set.
+func (unionFind *UnionFind[V]) Connected(u, v V) bool {
+	uIndex, uIsPresent := unionFind.vertexToIndex[u]
+	vIndex, vIsPresent := unionFind.vertexToIndex[v]
+
+	return uIsPresent && vIsPresent && unionFind.find(uIndex) == unionFind.find(vIndex)
+}
+
+// Count returns the amount of sets.
+func (unionFind *UnionFind[V]) Count() int {
+	return unionFind.count
+}
+
+// Len returns the amount of vertices.
+func (unionFind *UnionFind[V]) Len() int {
+	return len(unionFind.indexToVertex)
+}
+
+func (unionFind *UnionFind[V]) indexOf(vertex V) int {
+	index, isPresent := unionFind.vertexToIndex[vertex]
+
+	if !isPresent {
+		index = len(unionFind.indexToVertex)
+		unionFind.vertexToIndex[vertex] = index
+		unionFind.indexToVertex = append(unionFind.indexToVertex, vertex)
+		unionFind.parent = append(unionFind.parent, index)
+		unionFind.size = append(unionFind.size, 1)
+		unionFind.count++
+	}
+
+	return index
+}
+
+func (unionFind *UnionFind[V]) find(index int) int {
+	for unionFind.parent[index] != index {
+		unionFind.parent[index] = unionFind.parent[unionFind.parent[index]]
+		index = unionFind.parent[index]
+	}
+
+	return index
+}
@@ -0,0 +1,58 @@
+package connectivity
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph"
+	"slices"
+)
+
+// WeakConnectivity creates UnionFind of vertices of digraph where sets are
+// weakly connected components, so more edges may be added to it with
+// UnionFind.Union and connectivity queries stay fast.
+//
+// It panics if digraph is nil.
+//
+// https://en.wikipedia.org/wiki/Connectivity_(graph_theory)#Connected_vertices_and_graphs
+func WeakConnectivity[V graph.Vertex](digraph digraph.Digraph[V]) *UnionFind[V] {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+
+	unionFind := NewUnionFind[V]()
+
+	for vertex := range digraph.AllVertices() {
+		unionFind.Add(vertex)
+	}
+
+	for edge := range digraph.AllEdges() {
+		unionFind.Union(edge.Source(), edge.Target())
+	}
+
+	return unionFind
+}
+
+// WeaklyConnectedComponents computes weakly connected Components of
+// digraph, which are connected components if edge directions are ignored.
+//
+// It panics if digraph is nil.
+func WeaklyConnectedComponents[V graph.Vertex](digraph digraph.Digraph[V]) *Components[V] {
+	unionFind := WeakConnectivity(digraph)
+	indexer := graph.NewIndexer(slices.Values(unionFind.indexToVertex))
+
+	rootToComponent := make(map[int]int)
+	componentOfIndex := make([]int, unionFind.Len())
+
+	for i := range componentOfIndex {
+		root := unionFind.find(i)
+		component, isPresent := rootToComponent[root]
+
+		if !isPresent {
+			component = len(rootToComponent)
+			rootToComponent[root] = component
+		}
+
+		componentOfIndex[i] = component
+	}
+
+	return newComponents(indexer, componentOfIndex, len(rootToComponent))
+}
@@ -0,0 +1,48 @@
+package connectivity
+
+import (
+	"goraph/graph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeaklyConnectedComponents(t *testing.T) {
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4, 5, 6),
+		mapset.NewFromElements(graph.NewEdge(1, 2), graph.NewEdge(3, 2), graph.NewEdge(4, 5)),
+	)
+
+	components := WeaklyConnectedComponents(simpleDigraph)
+
+	assert.Equal(t, 3, components.Count())
+	assert.ElementsMatch(t, []int{1, 2, 3}, components.Component(3))
+	assert.ElementsMatch(t, []int{4, 5}, components.Component(4))
+	assert.Equal(t, []int{6}, components.Component(6))
+}
+
+func TestWeakConnectivity(t *testing.T) {
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4),
+		mapset.NewFromElements(graph.NewEdge(1, 2), graph.NewEdge(4, 3)),
+	)
+
+	unionFind := WeakConnectivity(simpleDigraph)
+
+	assert.Equal(t, 2, unionFind.Count())
+	assert.True(t, unionFind.Connected(2, 1))
+	assert.False(t, unionFind.Connected(1, 3))
+	assert.False(t, unionFind.Connected(1, 5))
+
+	assert.True(t, unionFind.Union(2, 3))
+	assert.False(t, unionFind.Union(1, 4))
+	assert.True(t, unionFind.Connected(1, 4))
+	assert.Equal(t, 1, unionFind.Count())
+	assert.Equal(t, unionFind.Find(1), unionFind.Find(4))
+
+	assert.True(t, unionFind.Union(4, 5))
+	assert.Equal(t, 5, unionFind.Len())
+	assert.Equal(t, 1, unionFind.Count())
+}
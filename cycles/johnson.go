@@ -0,0 +1,268 @@
+// Package cycles provides enumeration of elementary cycles of
+// simpledigraph.SimpleDigraph.
+package cycles
+
+import (
+	"goraph/connectivity"
+	"goraph/graph"
+	"goraph/graph/digraph"
+	"goraph/graph/digraph/simpledigraph"
+	"iter"
+	"slices"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// Options limits the enumeration of cycles.
+type Options struct {
+	// MaxLength, if positive, is the maximum amount of edges in a cycle,
+	// longer cycles are skipped.
+	MaxLength int
+
+	// MaxCount, if positive, is the maximum amount of cycles to enumerate.
+	MaxCount int
+}
+
+// Johnson returns an iter.Seq over all elementary cycles of simpleDigraph
+// computed lazily by Johnson's algorithm. Every cycle is a slice of its edges
+// in order, the target of the last edge is the source of the first one, and
+// every cycle is yielded exactly once.
+//
+// The search is run in strongly connected components only, so acyclic parts
+// of simpleDigraph are skipped. Without options.MaxLength the delay between
+// two cycles is O(V + E). With options.MaxLength vertices are unblocked
+// conservatively whenever the search is cut by the length bound, so bounded
+// enumeration is still exact but loses this guarantee.
+//
+// The returned iter.Seq may be iterated many times, every iteration repeats
+// the computation. simpleDigraph must not change during iteration.
+//
+// It panics if simpleDigraph is nil or options has negative limits.
+//
+// Time complexity: O((V + E)(C + 1)), where C is the amount of cycles.
+//
+// https://www.cs.tufts.edu/comp/150GA/homeworks/hw1/Johnson%2075.PDF
+func Johnson[V graph.Vertex](
+	simpleDigraph simpledigraph.SimpleDigraph[V],
+	options Options,
+) iter.Seq[[]graph.Edge[V]] {
+	if simpleDigraph == nil {
+		panic("simpleDigraph == nil")
+	}
+	if options.MaxLength < 0 {
+		panic("options.MaxLength < 0")
+	}
+	if options.MaxCount < 0 {
+		panic("options.MaxCount < 0")
+	}
+
+	return func(yield func([]graph.Edge[V]) bool) {
+		search := newCircuitSearch(simpleDigraph, options)
+		components := search.components(simpleDigraph)
+
+		for len(components) > 0 {
+			component := components[len(components)-1]
+			components = components[:len(components)-1]
+
+			start := slices.Min(component)
+
+			if !search.circuits(component, start, yield) {
+				return
+			}
+
+			rest := slices.DeleteFunc(component, func(u int) bool {
+				return u == start
+			})
+			vertices := mapset.New[V]()
+
+			for _, u := range rest {
+				vertices.Add(search.indexer.Vertex(u))
+			}
+
+			components = append(components, search.components(digraph.InducedSubgraph(simpleDigraph, vertices))...)
+		}
+	}
+}
+
+// circuitSearch holds the state of Johnson's algorithm over indices of
+// vertices.
+type circuitSearch[V graph.Vertex] struct {
+	indexedDigraph *digraph.IndexedDigraph[V]
+	indexer        *graph.Indexer[V]
+	options        Options
+	count          int
+
+	// isInComponent marks vertices of the current component.
+	isInComponent []bool
+	isBlocked     []bool
+
+	// blockedBy[v] holds vertices to unblock when v is unblocked.
+	blockedBy []map[int]struct{}
+}
+
+func newCircuitSearch[V graph.Vertex](
+	simpleDigraph simpledigraph.SimpleDigraph[V],
+	options Options,
+) *circuitSearch[V] {
+	indexedDigraph := digraph.NewIndexedDigraph[V](simpleDigraph)
+	n := indexedDigraph.Order()
+
+	return &circuitSearch[V]{
+		indexedDigraph: indexedDigraph,
+		indexer:        indexedDigraph.Indexer(),
+		options:        options,
+		isInComponent:  make([]bool, n),
+		isBlocked:      make([]bool, n),
+		blockedBy:      make([]map[int]struct{}, n),
+	}
+}
+
+// components returns indices of vertices of strongly connected components
+// of subgraph that may contain cycles.
+func (search *circuitSearch[V]) components(subgraph digraph.Digraph[V]) [][]int {
+	components := connectivity.NewTarjan[V]().Compute(subgraph)
+	indexComponents := make([][]int, 0)
+
+	for _, component := range components.Components {
+		if len(component) < 2 {
+			continue
+		}
+
+		indices := make([]int, len(component))
+
+		for i, vertex := range component {
+			indices[i], _ = search.indexer.Index(vertex)
+		}
+
+		indexComponents = append(indexComponents, indices)
+	}
+
+	return indexComponents
+}
+
+// circuitFrame is a vertex on the path with the position of its next
+// successor to examine and whether some cycle was found through it.
+type circuitFrame struct {
+	u             int
+	nextSuccessor int
+	isClosed      bool
+}
+
+// circuits yields all cycles through start in component and returns false
+// if the enumeration must stop.
+func (search *circuitSearch[V]) circuits(
+	component []int,
+	start int,
+	yield func([]graph.Edge[V]) bool,
+) bool {
+	for _, u := range component {
+		search.isInComponent[u] = true
+		search.isBlocked[u] = false
+		search.blockedBy[u] = nil
+	}
+
+	defer func() {
+		for _, u := range component {
+			search.isInComponent[u] = false
+		}
+	}()
+
+	maxLength := search.options.MaxLength
+	path := []circuitFrame{{u: start}}
+	search.isBlocked[start] = true
+
+	for len(path) > 0 {
+		frame := &path[len(path)-1]
+		successors := search.indexedDigraph.Successors(frame.u)
+
+		if frame.nextSuccessor < len(successors) {
+			w := successors[frame.nextSuccessor]
+			frame.nextSuccessor++
+
+			switch {
+			case w == start:
+				frame.isClosed = true
+
+				if !search.yieldCycle(path, yield) {
+					return false
+				}
+
+			case !search.isInComponent[w] || search.isBlocked[w]:
+
+			case maxLength > 0 && len(path) >= maxLength:
+				// the search is cut, so w must not stay blocked
+				// because of it
+				frame.isClosed = true
+
+			default:
+				search.isBlocked[w] = true
+				path = append(path, circuitFrame{u: w})
+			}
+
+			continue
+		}
+
+		path = path[:len(path)-1]
+
+		if frame.isClosed {
+			search.unblock(frame.u)
+		} else {
+			for _, w := range successors {
+				if search.isInComponent[w] {
+					if search.blockedBy[w] == nil {
+						search.blockedBy[w] = make(map[int]struct{})
+					}
+
+					search.blockedBy[w][frame.u] = struct{}{}
+				}
+			}
+		}
+
+		if len(path) > 0 && frame.isClosed {
+			path[len(path)-1].isClosed = true
+		}
+	}
+
+	return true
+}
+
+// yieldCycle yields the cycle that consists of path and the edge back to its
+// first vertex and returns false if the enumeration must stop.
+func (search *circuitSearch[V]) yieldCycle(path []circuitFrame, yield func([]graph.Edge[V]) bool) bool {
+	cycle := make([]graph.Edge[V], len(path))
+
+	for i, frame := range path {
+		target := path[(i+1)%len(path)].u
+
+		cycle[i] = graph.NewEdge(search.indexer.Vertex(frame.u), search.indexer.Vertex(target))
+	}
+
+	search.count++
+
+	if !yield(cycle) {
+		return false
+	}
+
+	return search.options.MaxCount == 0 || search.count < search.options.MaxCount
+}
+
+func (search *circuitSearch[V]) unblock(u int) {
+	stack := []int{u}
+
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if !search.isBlocked[v] {
+			continue
+		}
+
+		search.isBlocked[v] = false
+
+		for w := range search.blockedBy[v] {
+			stack = append(stack, w)
+		}
+
+		search.blockedBy[v] = nil
+	}
+}
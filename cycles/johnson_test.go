@@ -0,0 +1,134 @@
+package cycles
+
+import (
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/graph/generate"
+	"slices"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+// canonicalKeys returns keys of cycles rotated to start from their least
+// vertex, checking that every cycle is a closed walk of simpleDigraph.
+func canonicalKeys(t *testing.T, simpleDigraph simpledigraph.SimpleDigraph[int], cycles [][]graph.Edge[int]) []string {
+	keys := make([]string, 0, len(cycles))
+
+	for _, cycle := range cycles {
+		vertices := make([]int, len(cycle))
+
+		for i, edge := range cycle {
+			assert.NotNil(t, simpleDigraph.Edge(edge.Source(), edge.Target()))
+			assert.Equal(t, edge.Target(), cycle[(i+1)%len(cycle)].Source())
+
+			vertices[i] = edge.Source()
+		}
+
+		least := slices.Index(vertices, slices.Min(vertices))
+		keys = append(keys, fmt.Sprint(slices.Concat(vertices[least:], vertices[:least])))
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// bruteForceKeys enumerates simple paths from every vertex through greater
+// vertices only, so every cycle is found once from its least vertex.
+func bruteForceKeys(simpleDigraph simpledigraph.SimpleDigraph[int], maxLength int) []string {
+	keys := make([]string, 0)
+
+	var extend func(path []int)
+
+	extend = func(path []int) {
+		for w := range simpleDigraph.SuccessorsSeq(path[len(path)-1]) {
+			switch {
+			case w == path[0]:
+				if maxLength == 0 || len(path) <= maxLength {
+					keys = append(keys, fmt.Sprint(path))
+				}
+
+			case w > path[0] && !slices.Contains(path, w):
+				extend(append(slices.Clone(path), w))
+			}
+		}
+	}
+
+	for s := range simpleDigraph.AllVertices() {
+		extend([]int{s})
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+func TestJohnson(t *testing.T) {
+	// K5 has C(5, k) * (k - 1)! cycles of length k
+	complete, err := generate.Complete(5)
+
+	assert.Nil(t, err)
+
+	cycles := slices.Collect(Johnson(complete, Options{}))
+
+	assert.Len(t, cycles, 10+20+30+24)
+	assert.Equal(t, bruteForceKeys(complete, 0), canonicalKeys(t, complete, cycles))
+
+	assert.Len(t, slices.Collect(Johnson(complete, Options{MaxLength: 3})), 10+20)
+	assert.Len(t, slices.Collect(Johnson(complete, Options{MaxCount: 7})), 7)
+
+	dag, err := generate.RandomDAG(50, 0.2, generate.NewRand(1))
+
+	assert.Nil(t, err)
+
+	assert.Empty(t, slices.Collect(Johnson(dag, Options{})))
+
+	for range Johnson(complete, Options{}) {
+		break
+	}
+
+	assert.Panics(t, func() { Johnson[int](nil, Options{}) })
+	assert.Panics(t, func() { Johnson(complete, Options{MaxLength: -1}) })
+}
+
+func TestJohnson_Chain(t *testing.T) {
+	// many 2-cycles connected by a long acyclic chain
+	vertices := mapset.New[int]()
+	edges := mapset.New[graph.Edge[int]]()
+
+	for vertex := 0; vertex < 1000; vertex++ {
+		vertices.Add(vertex)
+
+		if vertex > 0 {
+			edges.Add(graph.NewEdge(vertex-1, vertex))
+		}
+		if vertex%100 == 1 {
+			edges.Add(graph.NewEdge(vertex, vertex-1))
+		}
+	}
+
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+	cycles := slices.Collect(Johnson(simpleDigraph, Options{}))
+
+	assert.Len(t, cycles, 10)
+}
+
+func TestJohnson_Random(t *testing.T) {
+	rng := generate.NewRand(1)
+
+	for i := range 100 {
+		simpleDigraph, err := generate.ErdosRenyiGnp(9, 0.1+float64(i%4)*0.1, rng)
+
+		assert.Nil(t, err)
+
+		maxLength := i % 5
+
+		cycles := slices.Collect(Johnson(simpleDigraph, Options{MaxLength: maxLength}))
+
+		assert.Equal(t, bruteForceKeys(simpleDigraph, maxLength), canonicalKeys(t, simpleDigraph, cycles))
+	}
+}
@@ -0,0 +1,33 @@
+package dominator
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph"
+	"goraph/internal/dominance"
+)
+
+type cooperHarveyKennedy[V graph.Vertex] struct{}
+
+var _ Dominators[struct{}] = (*cooperHarveyKennedy[struct{}])(nil)
+
+// NewCooperHarveyKennedy creates a Cooper-Harvey-Kennedy algorithm
+// implementation of Dominators. It's an iterative data-flow algorithm over
+// reverse postorder that intersects dominators of predecessors in the Tree
+// being built.
+//
+// Its worst-case time complexity is O(V * E), but it usually converges in
+// a couple of passes and is faster than Lengauer-Tarjan algorithm on
+// control-flow graphs.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://www.cs.tufts.edu/comp/150FP/archive/keith-cooper/dom14.pdf
+func NewCooperHarveyKennedy[V graph.Vertex]() Dominators[V] {
+	return cooperHarveyKennedy[V]{}
+}
+
+func (algorithm cooperHarveyKennedy[V]) Compute(digraph digraph.Digraph[V], entry V) *Tree[V] {
+	flow := newFlowGraph(digraph, entry)
+
+	return newTree(flow, dominance.CooperHarveyKennedy(flow.predecessors, flow.postorder))
+}
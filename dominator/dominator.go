@@ -0,0 +1,132 @@
+// Package dominator provides dominator trees of flow graphs: digraphs
+// with an entry vertex from which the flow starts.
+//
+// https://en.wikipedia.org/wiki/Dominator_(graph_theory)
+package dominator
+
+import (
+	"goraph/graph"
+	dg "goraph/graph/digraph"
+)
+
+// Dominators interface represents an algorithm with single method
+// that computes the dominator Tree of a flow graph.
+type Dominators[V graph.Vertex] interface {
+	// Compute computes the dominator Tree of digraph with entry vertex.
+	// Vertices not reachable from entry are not present in the Tree.
+	//
+	// It panics if digraph is nil or entry is not present in digraph.
+	Compute(digraph dg.Digraph[V], entry V) *Tree[V]
+}
+
+// PostDominators computes the post-dominator Tree of digraph with exit
+// vertex: it's the dominator Tree of the reversed digraph, so vertex u
+// post-dominates v iff every path from v to exit contains u. Vertices
+// from which exit is not reachable are not present in the Tree.
+//
+// Dominance frontiers of the post-dominator Tree are post-dominance
+// frontiers, which give control dependences in a control-flow graph.
+//
+// It panics if dominators or digraph is nil or exit is not present in digraph.
+//
+// https://en.wikipedia.org/wiki/Dominator_(graph_theory)#Postdominance
+func PostDominators[V graph.Vertex](
+	dominators Dominators[V],
+	digraph dg.Digraph[V],
+	exit V,
+) *Tree[V] {
+	if dominators == nil {
+		panic("dominators == nil")
+	}
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+
+	return dominators.Compute(dg.Reverse(digraph), exit)
+}
+
+// flowGraph is digraph restricted to vertices reachable from entry, which
+// are numbered in preorder of depth-first search from entry, so entry is 0.
+type flowGraph[V graph.Vertex] struct {
+	vertices     []V
+	predecessors [][]int
+
+	// parent maps vertex to its parent in the depth-first search tree.
+	parent []int
+
+	// postorder holds vertices in postorder of the depth-first search.
+	postorder []int
+}
+
+// dfsFrame is a vertex on the depth-first search stack with the position
+// of its next successor to examine.
+type dfsFrame struct {
+	u             int
+	nextSuccessor int
+}
+
+func newFlowGraph[V graph.Vertex](original dg.Digraph[V], entry V) *flowGraph[V] {
+	if original == nil {
+		panic("digraph == nil")
+	}
+
+	indexedDigraph := dg.NewIndexedDigraph(original)
+	indexer := indexedDigraph.Indexer()
+	entryIndex, isPresent := indexer.Index(entry)
+
+	if !isPresent {
+		panic("entry is not present in digraph")
+	}
+
+	const unreached = -1
+
+	preorderNumber := make([]int, indexedDigraph.Order())
+
+	for i := range preorderNumber {
+		preorderNumber[i] = unreached
+	}
+
+	flow := &flowGraph[V]{
+		vertices:  []V{entry},
+		parent:    []int{unreached},
+		postorder: make([]int, 0),
+	}
+	preorderNumber[entryIndex] = 0
+	callStack := []dfsFrame{{u: entryIndex}}
+
+	for len(callStack) > 0 {
+		frame := &callStack[len(callStack)-1]
+		successors := indexedDigraph.Successors(frame.u)
+
+		if frame.nextSuccessor < len(successors) {
+			v := successors[frame.nextSuccessor]
+			frame.nextSuccessor++
+
+			if preorderNumber[v] == unreached {
+				preorderNumber[v] = len(flow.vertices)
+				flow.vertices = append(flow.vertices, indexer.Vertex(v))
+				flow.parent = append(flow.parent, preorderNumber[frame.u])
+				callStack = append(callStack, dfsFrame{u: v})
+			}
+
+			continue
+		}
+
+		flow.postorder = append(flow.postorder, preorderNumber[frame.u])
+		callStack = callStack[:len(callStack)-1]
+	}
+
+	flow.predecessors = make([][]int, len(flow.vertices))
+
+	for i, vertex := range flow.vertices {
+		u, _ := indexer.Index(vertex)
+
+		for _, v := range indexedDigraph.Predecessors(u) {
+			if preorderNumber[v] != unreached {
+				flow.predecessors[i] = append(flow.predecessors[i], preorderNumber[v])
+			}
+		}
+	}
+
+	return flow
+}
@@ -0,0 +1,170 @@
+package dominator
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/graph/generate"
+	"goraph/graph/traverse"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+var algorithms = map[string]Dominators[int]{
+	"LengauerTarjan":      NewLengauerTarjan[int](),
+	"CooperHarveyKennedy": NewCooperHarveyKennedy[int](),
+}
+
+// isReachableWithout returns true iff v is reachable from entry
+// without visiting u.
+func isReachableWithout(flowGraph digraph.Digraph[int], entry, u, v int) bool {
+	if entry == u {
+		return false
+	}
+
+	withoutU := digraph.FilterEdges(flowGraph, func(edge graph.Edge[int]) bool {
+		return edge.Source() != u && edge.Target() != u
+	})
+
+	return traverse.BreadthFirstSearch(withoutU, []int{entry}, traverse.Visitor[int]{}, traverse.Options{}).IsReached(v)
+}
+
+func TestDominators_Compute(t *testing.T) {
+	// 1 -> 2 -> {3, 4} -> 5 -> 2 (loop), 5 -> 6, 7 is unreachable
+	controlFlowGraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4, 5, 6, 7),
+		mapset.NewFromElements(
+			graph.NewEdge(1, 2),
+			graph.NewEdge(2, 3),
+			graph.NewEdge(2, 4),
+			graph.NewEdge(3, 5),
+			graph.NewEdge(4, 5),
+			graph.NewEdge(5, 2),
+			graph.NewEdge(5, 6),
+			graph.NewEdge(7, 6),
+		),
+	)
+
+	for name, algorithm := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			tree := algorithm.Compute(controlFlowGraph, 1)
+
+			assert.Equal(t, 1, tree.Entry())
+			assert.False(t, tree.Contains(7))
+
+			for vertex, expected := range map[int]int{2: 1, 3: 2, 4: 2, 5: 2, 6: 5} {
+				idom, isPresent := tree.ImmediateDominator(vertex)
+
+				assert.True(t, isPresent)
+				assert.Equal(t, expected, idom)
+			}
+
+			_, isPresent := tree.ImmediateDominator(1)
+
+			assert.False(t, isPresent)
+			assert.ElementsMatch(t, []int{3, 4, 5}, tree.Children(2))
+			assert.Equal(t, []int{6, 5, 2, 1}, tree.Dominators(6))
+			assert.True(t, tree.Dominates(2, 6))
+			assert.False(t, tree.Dominates(3, 5))
+			assert.False(t, tree.Dominates(1, 7))
+			assert.Equal(t, 5, tree.SimpleDigraph().Size())
+
+			frontiers := tree.DominanceFrontiers()
+
+			assert.ElementsMatch(t, []int{5}, frontiers[3].Elements())
+			assert.ElementsMatch(t, []int{5}, frontiers[4].Elements())
+			assert.ElementsMatch(t, []int{2}, frontiers[5].Elements())
+			assert.ElementsMatch(t, []int{2}, frontiers[2].Elements())
+			assert.Empty(t, frontiers[1].Elements())
+
+			postDominatorTree := PostDominators(algorithm, controlFlowGraph, 6)
+			idom, _ := postDominatorTree.ImmediateDominator(3)
+
+			assert.Equal(t, 5, idom)
+			assert.True(t, postDominatorTree.Dominates(2, 1))
+			assert.True(t, postDominatorTree.Contains(7))
+
+			assert.Panics(t, func() { algorithm.Compute(controlFlowGraph, 8) })
+		})
+	}
+}
+
+func TestDominators_Compute_Random(t *testing.T) {
+	rng := generate.NewRand(1)
+
+	for i := range 50 {
+		simpleDigraph, err := generate.ErdosRenyiGnp(15, 0.08+float64(i%3)*0.05, rng)
+
+		assert.Nil(t, err)
+
+		entry := 1 + i%15
+
+		for name, algorithm := range algorithms {
+			tree := algorithm.Compute(simpleDigraph, entry)
+			isReached := traverse.BreadthFirstSearch(
+				simpleDigraph, []int{entry}, traverse.Visitor[int]{}, traverse.Options{},
+			).IsReached
+
+			for u := range simpleDigraph.AllVertices() {
+				assert.Equal(t, isReached(u), tree.Contains(u), name)
+
+				for v := range simpleDigraph.AllVertices() {
+					expected := isReached(u) && isReached(v) &&
+						(u == v || !isReachableWithout(simpleDigraph, entry, u, v))
+
+					assert.Equal(t, expected, tree.Dominates(u, v), name)
+				}
+			}
+
+			// the dominance frontier of u holds w iff u dominates some
+			// predecessor of w, but doesn't strictly dominate w
+			frontiers := tree.DominanceFrontiers()
+
+			for u := range simpleDigraph.AllVertices() {
+				if !tree.Contains(u) {
+					continue
+				}
+
+				for w := range simpleDigraph.AllVertices() {
+					dominatesPredecessor := false
+
+					for p := range simpleDigraph.PredecessorsSeq(w) {
+						dominatesPredecessor = dominatesPredecessor || tree.Dominates(u, p)
+					}
+
+					expected := dominatesPredecessor && (u == w || !tree.Dominates(u, w))
+
+					assert.Equal(t, expected, frontiers[u].Contains(w), name)
+				}
+			}
+		}
+	}
+}
+
+func TestDominators_Compute_LongPath(t *testing.T) {
+	amountOfVertices := 200_000
+	vertices := mapset.New[int]()
+	edges := mapset.New[graph.Edge[int]]()
+
+	for vertex := 0; vertex < amountOfVertices; vertex++ {
+		vertices.Add(vertex)
+
+		if vertex > 0 {
+			edges.Add(graph.NewEdge(vertex-1, vertex))
+			edges.Add(graph.NewEdge(vertex, 0))
+		}
+	}
+
+	path, _ := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	for name, algorithm := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			tree := algorithm.Compute(path, 0)
+
+			assert.True(t, tree.Dominates(1, amountOfVertices-1))
+			assert.Len(t, tree.Dominators(amountOfVertices-1), amountOfVertices)
+		})
+	}
+}
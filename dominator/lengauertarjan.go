@@ -0,0 +1,99 @@
+package dominator
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph"
+)
+
+type lengauerTarjan[V graph.Vertex] struct{}
+
+var _ Dominators[struct{}] = (*lengauerTarjan[struct{}])(nil)
+
+// NewLengauerTarjan creates a Lengauer-Tarjan algorithm implementation
+// of Dominators. It's the simple version with path compression, which
+// computes semidominators and derives immediate dominators from them.
+//
+// Time complexity: O(E log V).
+//
+// This implementation is immutable and thread-safe.
+//
+// https://doi.org/10.1145/357062.357071
+func NewLengauerTarjan[V graph.Vertex]() Dominators[V] {
+	return lengauerTarjan[V]{}
+}
+
+func (algorithm lengauerTarjan[V]) Compute(digraph digraph.Digraph[V], entry V) *Tree[V] {
+	flow := newFlowGraph(digraph, entry)
+	n := len(flow.vertices)
+
+	const noAncestor = -1
+
+	// vertices are preorder numbers, so semi holds vertices as well
+	semi := make([]int, n)
+	label := make([]int, n)
+	ancestor := make([]int, n)
+	idom := make([]int, n)
+	buckets := make([][]int, n)
+	path := make([]int, 0)
+
+	for v := range n {
+		semi[v] = v
+		label[v] = v
+		ancestor[v] = noAncestor
+	}
+
+	// eval returns the vertex with the least semidominator on the path
+	// from v up to the root of its tree in the forest, compressing the path.
+	eval := func(v int) int {
+		if ancestor[v] == noAncestor {
+			return v
+		}
+
+		path = path[:0]
+
+		for u := v; ancestor[ancestor[u]] != noAncestor; u = ancestor[u] {
+			path = append(path, u)
+		}
+
+		for i := len(path) - 1; i >= 0; i-- {
+			u := path[i]
+
+			if semi[label[ancestor[u]]] < semi[label[u]] {
+				label[u] = label[ancestor[u]]
+			}
+
+			ancestor[u] = ancestor[ancestor[u]]
+		}
+
+		return label[v]
+	}
+
+	for w := n - 1; w >= 1; w-- {
+		for _, v := range flow.predecessors[w] {
+			semi[w] = min(semi[w], semi[eval(v)])
+		}
+
+		buckets[semi[w]] = append(buckets[semi[w]], w)
+
+		p := flow.parent[w]
+		ancestor[w] = p
+
+		for _, v := range buckets[p] {
+			if u := eval(v); semi[u] < semi[v] {
+				idom[v] = u
+			} else {
+				idom[v] = p
+			}
+		}
+
+		buckets[p] = nil
+	}
+
+	for w := 1; w < n; w++ {
+		if idom[w] != semi[w] {
+			idom[w] = idom[idom[w]]
+		}
+	}
+
+	return newTree(flow, idom)
+}
@@ -0,0 +1,177 @@
+package dominator
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/internal/dominance"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set"
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// Tree is the dominator tree of a flow graph: the parent of every vertex
+// other than the entry is its immediate dominator.
+//
+// This implementation is immutable and thread-safe.
+type Tree[V graph.Vertex] struct {
+	flow          *flowGraph[V]
+	vertexToIndex map[V]int
+	dominance     *dominance.Tree
+}
+
+func newTree[V graph.Vertex](flow *flowGraph[V], idom []int) *Tree[V] {
+	tree := &Tree[V]{
+		flow:          flow,
+		vertexToIndex: make(map[V]int, len(flow.vertices)),
+		dominance:     dominance.NewTree(idom, 0),
+	}
+
+	for v, vertex := range flow.vertices {
+		tree.vertexToIndex[vertex] = v
+	}
+
+	return tree
+}
+
+// Entry returns the entry vertex of the flow graph.
+func (tree *Tree[V]) Entry() V {
+	return tree.flow.vertices[0]
+}
+
+// Contains returns true iff vertex is reachable from the entry.
+func (tree *Tree[V]) Contains(vertex V) bool {
+	_, isPresent := tree.vertexToIndex[vertex]
+
+	return isPresent
+}
+
+// ImmediateDominator returns the immediate dominator of vertex and true or
+// false if vertex is the entry or is not present in this Tree.
+func (tree *Tree[V]) ImmediateDominator(vertex V) (V, bool) {
+	v, isPresent := tree.vertexToIndex[vertex]
+
+	if !isPresent || v == 0 {
+		var zero V
+
+		return zero, false
+	}
+
+	return tree.flow.vertices[tree.dominance.Idom[v]], true
+}
+
+// Children returns vertices which immediate dominator is vertex.
+func (tree *Tree[V]) Children(vertex V) []V {
+	v, isPresent := tree.vertexToIndex[vertex]
+
+	if !isPresent {
+		return nil
+	}
+
+	children := make([]V, len(tree.dominance.Children[v]))
+
+	for i, child := range tree.dominance.Children[v] {
+		children[i] = tree.flow.vertices[child]
+	}
+
+	return children
+}
+
+// Dominates returns true iff u dominates v: every path from the entry to v
+// contains u. Every vertex dominates itself.
+//
+// It returns false if u or v is not present in this Tree.
+//
+// Time complexity: O(1).
+func (tree *Tree[V]) Dominates(u, v V) bool {
+	uIndex, isPresent := tree.vertexToIndex[u]
+
+	if !isPresent {
+		return false
+	}
+
+	vIndex, isPresent := tree.vertexToIndex[v]
+
+	if !isPresent {
+		return false
+	}
+
+	return tree.dominance.Dominates(uIndex, vIndex)
+}
+
+// Dominators returns all dominators of vertex from vertex itself up to
+// the entry or nil if vertex is not present in this Tree.
+func (tree *Tree[V]) Dominators(vertex V) []V {
+	v, isPresent := tree.vertexToIndex[vertex]
+
+	if !isPresent {
+		return nil
+	}
+
+	dominators := []V{vertex}
+
+	for v != 0 {
+		v = tree.dominance.Idom[v]
+		dominators = append(dominators, tree.flow.vertices[v])
+	}
+
+	return dominators
+}
+
+// DominanceFrontiers returns the dominance frontier of every vertex of this
+// Tree: the set of vertices w such that vertex dominates a predecessor of w,
+// but doesn't strictly dominate w.
+//
+// It's the algorithm of Cooper, Harvey and Kennedy that walks up from
+// predecessors of every join vertex.
+//
+// Time complexity: O(E + size of frontiers).
+//
+// https://en.wikipedia.org/wiki/Static_single-assignment_form#Computing_minimal_SSA_using_dominance_frontiers
+func (tree *Tree[V]) DominanceFrontiers() map[V]set.Set[V] {
+	frontiers := make(map[V]set.Set[V], len(tree.flow.vertices))
+
+	for _, vertex := range tree.flow.vertices {
+		frontiers[vertex] = mapset.New[V]()
+	}
+
+	for w, predecessors := range tree.flow.predecessors {
+		// a single predecessor is the immediate dominator, unless w is
+		// the entry, which is not strictly dominated by any vertex
+		if w != 0 && len(predecessors) < 2 {
+			continue
+		}
+
+		for _, p := range predecessors {
+			for runner := p; w == 0 || runner != tree.dominance.Idom[w]; runner = tree.dominance.Idom[runner] {
+				frontiers[tree.flow.vertices[runner]].Add(tree.flow.vertices[w])
+
+				if runner == 0 {
+					break
+				}
+			}
+		}
+	}
+
+	return frontiers
+}
+
+// SimpleDigraph creates an immutable simpledigraph.SimpleDigraph using
+// adjacency list ADT with all vertices of this Tree and edge (idom(v), v)
+// for every vertex v other than the entry.
+func (tree *Tree[V]) SimpleDigraph() simpledigraph.SimpleDigraph[V] {
+	vertices := mapset.NewFromElements(tree.flow.vertices...)
+	edges := mapset.New[graph.Edge[V]]()
+
+	for v := 1; v < len(tree.flow.vertices); v++ {
+		edges.Add(graph.NewEdge(tree.flow.vertices[tree.dominance.Idom[v]], tree.flow.vertices[v]))
+	}
+
+	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return simpleDigraph
+}
@@ -0,0 +1,139 @@
+package attribute
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Type is a type of attribute Value.
+type Type int
+
+const (
+	// String Value holds string Data.
+	String Type = iota
+
+	// Bool Value holds bool Data.
+	Bool
+
+	// Int Value holds int32 Data.
+	Int
+
+	// Long Value holds int64 Data.
+	Long
+
+	// Float Value holds float32 Data.
+	Float
+
+	// Double Value holds float64 Data.
+	Double
+
+	// Unknown Value holds string Data that is kept as is, so attributes
+	// of types unknown to goraph are not lost when a file is read and
+	// written back.
+	Unknown
+)
+
+// Value is a typed attribute value.
+type Value struct {
+	Type Type
+
+	// Data has a Go type corresponding to Type.
+	Data any
+
+	// UnknownType is a format-specific type name of Unknown Value,
+	// it is empty for other types.
+	UnknownType string
+}
+
+// NewString creates a String Value.
+func NewString(data string) Value {
+	return Value{Type: String, Data: data}
+}
+
+// NewBool creates a Bool Value.
+func NewBool(data bool) Value {
+	return Value{Type: Bool, Data: data}
+}
+
+// NewInt creates an Int Value.
+func NewInt(data int32) Value {
+	return Value{Type: Int, Data: data}
+}
+
+// NewLong creates a Long Value.
+func NewLong(data int64) Value {
+	return Value{Type: Long, Data: data}
+}
+
+// NewFloat creates a Float Value.
+func NewFloat(data float32) Value {
+	return Value{Type: Float, Data: data}
+}
+
+// NewDouble creates a Double Value.
+func NewDouble(data float64) Value {
+	return Value{Type: Double, Data: data}
+}
+
+// NewUnknown creates an Unknown Value.
+func NewUnknown(unknownType string, data string) Value {
+	return Value{Type: Unknown, Data: data, UnknownType: unknownType}
+}
+
+// Parse parses text as Value of type valueType.
+//
+// Unknown values can't be parsed, use NewUnknown instead.
+func Parse(valueType Type, text string) (Value, error) {
+	switch valueType {
+	case String:
+		return NewString(text), nil
+
+	case Bool:
+		data, err := strconv.ParseBool(text)
+
+		return NewBool(data), err
+
+	case Int:
+		data, err := strconv.ParseInt(text, 10, 32)
+
+		return NewInt(int32(data)), err
+
+	case Long:
+		data, err := strconv.ParseInt(text, 10, 64)
+
+		return NewLong(data), err
+
+	case Float:
+		data, err := strconv.ParseFloat(text, 32)
+
+		return NewFloat(float32(data)), err
+
+	case Double:
+		data, err := strconv.ParseFloat(text, 64)
+
+		return NewDouble(data), err
+	}
+
+	return Value{}, fmt.Errorf("can't parse value of type %d", valueType)
+}
+
+// String formats Data of this Value, so that Parse(value.Type, value.String())
+// returns the same Value for all types except Unknown.
+func (value Value) String() string {
+	switch data := value.Data.(type) {
+	case string:
+		return data
+	case bool:
+		return strconv.FormatBool(data)
+	case int32:
+		return strconv.FormatInt(int64(data), 10)
+	case int64:
+		return strconv.FormatInt(data, 10)
+	case float32:
+		return strconv.FormatFloat(float64(data), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(data, 'g', -1, 64)
+	}
+
+	return fmt.Sprint(value.Data)
+}
@@ -0,0 +1,60 @@
+package attribute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	values := []Value{
+		NewString(" text "),
+		NewBool(true),
+		NewInt(-42),
+		NewLong(1 << 40),
+		NewFloat(1.5),
+		NewDouble(0.1),
+	}
+
+	for _, value := range values {
+		parsedValue, err := Parse(value.Type, value.String())
+
+		assert.Nil(t, err)
+		assert.Equal(t, value, parsedValue)
+	}
+
+	_, err := Parse(Int, "1 << 40")
+	assert.NotNil(t, err)
+
+	_, err = Parse(Unknown, "")
+	assert.NotNil(t, err)
+}
+
+func TestSchema(t *testing.T) {
+	store := NewStore[string]()
+
+	store.SetVertex("a", "size", NewInt(1))
+	store.SetVertex("b", "size", NewInt(2))
+	store.SetVertex("b", "tags", NewUnknown("liststring", "[x]"))
+
+	schema, err := Schema(store.Vertices)
+
+	assert.Nil(t, err)
+	assert.Equal(
+		t,
+		map[string]Declaration{
+			"size": {Type: Int},
+			"tags": {Type: Unknown, UnknownType: "liststring"},
+		},
+		schema,
+	)
+
+	value, isPresent := store.Vertex("b", "size")
+	assert.True(t, isPresent)
+	assert.Equal(t, NewInt(2), value)
+
+	store.SetVertex("c", "size", NewString("big"))
+
+	_, err = Schema(store.Vertices)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,114 @@
+package attribute
+
+import (
+	"fmt"
+	"goraph/graph"
+)
+
+// Store holds named typed attributes of a graph, its vertices and its edges.
+//
+// This implementation is not thread-safe.
+type Store[V graph.Vertex] struct {
+	// Graph maps attribute name to its Value.
+	Graph map[string]Value
+
+	// Vertices maps vertex to its attributes.
+	Vertices map[V]map[string]Value
+
+	// Edges maps edge to its attributes.
+	Edges map[graph.Edge[V]]map[string]Value
+
+	// XMLNamespaces maps XML namespace prefix to its URI. Readers of XML
+	// formats fill it, so that Unknown values that contain XML elements
+	// keep their namespaces when written back.
+	XMLNamespaces map[string]string
+}
+
+// NewStore creates an empty Store.
+func NewStore[V graph.Vertex]() *Store[V] {
+	return &Store[V]{
+		Graph:         make(map[string]Value),
+		Vertices:      make(map[V]map[string]Value),
+		Edges:         make(map[graph.Edge[V]]map[string]Value),
+		XMLNamespaces: make(map[string]string),
+	}
+}
+
+// Vertex returns Value of vertex attribute name and true iff it is present.
+func (store *Store[V]) Vertex(vertex V, name string) (Value, bool) {
+	value, isPresent := store.Vertices[vertex][name]
+
+	return value, isPresent
+}
+
+// SetVertex sets Value of vertex attribute name.
+func (store *Store[V]) SetVertex(vertex V, name string, value Value) {
+	if _, isPresent := store.Vertices[vertex]; !isPresent {
+		store.Vertices[vertex] = make(map[string]Value)
+	}
+
+	store.Vertices[vertex][name] = value
+}
+
+// Edge returns Value of edge attribute name and true iff it is present.
+func (store *Store[V]) Edge(edge graph.Edge[V], name string) (Value, bool) {
+	value, isPresent := store.Edges[edge][name]
+
+	return value, isPresent
+}
+
+// SetEdge sets Value of edge attribute name.
+func (store *Store[V]) SetEdge(edge graph.Edge[V], name string, value Value) {
+	if _, isPresent := store.Edges[edge]; !isPresent {
+		store.Edges[edge] = make(map[string]Value)
+	}
+
+	store.Edges[edge][name] = value
+}
+
+// Declaration describes the type of attribute values.
+type Declaration struct {
+	Type        Type
+	UnknownType string
+}
+
+// Schema returns Declaration of every attribute in attributes of all
+// vertices or edges (e.g. Store.Vertices or Store.Edges).
+//
+// It returns an error if some attribute has values of different types.
+func Schema[K comparable](attributes map[K]map[string]Value) (map[string]Declaration, error) {
+	schema := make(map[string]Declaration)
+
+	for _, keyAttributes := range attributes {
+		if err := addToSchema(schema, keyAttributes); err != nil {
+			return nil, err
+		}
+	}
+
+	return schema, nil
+}
+
+// GraphSchema is Schema for Store.Graph.
+func GraphSchema(attributes map[string]Value) (map[string]Declaration, error) {
+	schema := make(map[string]Declaration)
+
+	if err := addToSchema(schema, attributes); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+func addToSchema(schema map[string]Declaration, attributes map[string]Value) error {
+	for name, value := range attributes {
+		declaration := Declaration{value.Type, value.UnknownType}
+
+		if schemaDeclaration, isPresent := schema[name]; isPresent && schemaDeclaration != declaration {
+			return fmt.Errorf("attribute %q has values of different types", name)
+		}
+
+		schema[name] = declaration
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package dataset
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	"io"
+	"strconv"
+)
+
+// LoopPolicy defines what loaders do with loop edges,
+// which are not allowed in simpledigraph.SimpleDigraph.
+type LoopPolicy int
+
+const (
+	// DropLoops silently skips loop edges.
+	DropLoops LoopPolicy = iota
+
+	// RejectLoops makes a loader return an error on the first loop edge.
+	RejectLoops
+)
+
+// Options configures loaders.
+type Options struct {
+	Loops LoopPolicy
+}
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// decompressed returns a reader of r that transparently decompresses
+// gzip-compressed data. The reader must be closed, which doesn't close r.
+func decompressed(r io.Reader) (io.ReadCloser, error) {
+	if r == nil {
+		return nil, errors.New("r == nil")
+	}
+
+	reader := bufio.NewReader(r)
+	magic, err := reader.Peek(len(gzipMagic))
+
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	if len(magic) == len(gzipMagic) && magic[0] == gzipMagic[0] && magic[1] == gzipMagic[1] {
+		return gzip.NewReader(reader)
+	}
+
+	return io.NopCloser(reader), nil
+}
+
+// closeReader closes reader and, if that fails, replaces the loaded
+// simpleDigraph with nil and adds the failure to err.
+func closeReader(reader io.Closer, simpleDigraph *simpledigraph.SimpleDigraph[int], err *error) {
+	if closeErr := reader.Close(); closeErr != nil {
+		*simpleDigraph = nil
+		*err = errors.Join(*err, closeErr)
+	}
+}
+
+// lineError adds lineNumber to err.
+func lineError(lineNumber int, err error) error {
+	return fmt.Errorf("line %d: %w", lineNumber, err)
+}
+
+// acceptEdge returns false if edge is a loop that must be dropped
+// or an error if edge is a loop that must be rejected.
+func acceptEdge(edge graph.Edge[int], options Options) (bool, error) {
+	if edge.Source() != edge.Target() {
+		return true, nil
+	}
+
+	if options.Loops == RejectLoops {
+		return false, fmt.Errorf("loop edges are not allowed (%+v)", edge)
+	}
+
+	return false, nil
+}
+
+func parseVertex(field string) (int, error) {
+	vertex, err := strconv.Atoi(field)
+
+	if err != nil {
+		return 0, fmt.Errorf("%q is not an integer vertex", field)
+	}
+
+	return vertex, nil
+}
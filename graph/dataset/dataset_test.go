@@ -0,0 +1,138 @@
+package dataset
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"goraph/graph"
+	"strings"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const matrixMarketInput = `%%MatrixMarket matrix coordinate real general
+% comment
+3 4 4
+1 2 1.5
+2 3 -2
+3 3 1
+3 4 7
+`
+
+func TestLoadMatrixMarket(t *testing.T) {
+	simpleDigraph, err := LoadMatrixMarket(strings.NewReader(matrixMarketInput), Options{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, mapset.NewFromElements(1, 2, 3, 4), simpleDigraph.Vertices())
+	assert.Equal(
+		t,
+		mapset.NewFromElements(graph.NewEdge(1, 2), graph.NewEdge(2, 3), graph.NewEdge(3, 4)),
+		simpleDigraph.Edges(),
+	)
+
+	simpleDigraph, err = LoadMatrixMarket(strings.NewReader(matrixMarketInput), Options{Loops: RejectLoops})
+
+	assert.Nil(t, simpleDigraph)
+	assert.NotNil(t, err)
+}
+
+func TestLoadMatrixMarket_Symmetric(t *testing.T) {
+	input := `%%MatrixMarket matrix coordinate pattern symmetric
+3 3 2
+2 1
+3 2
+`
+
+	simpleDigraph, err := LoadMatrixMarket(strings.NewReader(input), Options{})
+
+	assert.Nil(t, err)
+	assert.Equal(
+		t,
+		mapset.NewFromElements(
+			graph.NewEdge(2, 1),
+			graph.NewEdge(1, 2),
+			graph.NewEdge(3, 2),
+			graph.NewEdge(2, 3),
+		),
+		simpleDigraph.Edges(),
+	)
+}
+
+func TestLoadMatrixMarket_Errors(t *testing.T) {
+	inputs := []string{
+		"",
+		"%%MatrixMarket matrix array real general\n2 2\n1\n2\n3\n4\n",
+		"%%MatrixMarket matrix coordinate real general\n2 2 1\n1 3 1\n",
+		"%%MatrixMarket matrix coordinate real general\n2 2 2\n1 2 1\n",
+	}
+
+	for _, input := range inputs {
+		simpleDigraph, err := LoadMatrixMarket(strings.NewReader(input), Options{})
+
+		assert.Nil(t, simpleDigraph)
+		assert.NotNil(t, err, input)
+	}
+}
+
+const snapInput = `# Directed graph: example.txt
+# FromNodeId	ToNodeId
+0	1
+1	2
+1	2
+2	2
+`
+
+func TestLoadSNAP(t *testing.T) {
+	simpleDigraph, err := LoadSNAP(strings.NewReader(snapInput), Options{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, mapset.NewFromElements(0, 1, 2), simpleDigraph.Vertices())
+	assert.Equal(
+		t,
+		mapset.NewFromElements(graph.NewEdge(0, 1), graph.NewEdge(1, 2)),
+		simpleDigraph.Edges(),
+	)
+
+	simpleDigraph, err = LoadSNAP(strings.NewReader(snapInput), Options{Loops: RejectLoops})
+
+	assert.Nil(t, simpleDigraph)
+	assert.NotNil(t, err)
+}
+
+func TestLoadSNAP_Gzip(t *testing.T) {
+	var buffer bytes.Buffer
+
+	writer := gzip.NewWriter(&buffer)
+	writer.Write([]byte(snapInput))
+	writer.Close()
+
+	compressed := buffer.Bytes()
+	simpleDigraph, err := LoadSNAP(bytes.NewReader(compressed), Options{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, simpleDigraph.Size())
+
+	// the checksum is missing from truncated data
+	simpleDigraph, err = LoadSNAP(bytes.NewReader(compressed[:len(compressed)-4]), Options{})
+
+	assert.Nil(t, simpleDigraph)
+	assert.NotNil(t, err)
+}
+
+type failingCloser struct{}
+
+func (failingCloser) Close() error {
+	return errors.New("close failed")
+}
+
+func TestCloseReader(t *testing.T) {
+	simpleDigraph, err := LoadSNAP(strings.NewReader(snapInput), Options{})
+
+	closeReader(failingCloser{}, &simpleDigraph, &err)
+
+	assert.Nil(t, simpleDigraph)
+	assert.EqualError(t, err, "close failed")
+}
@@ -0,0 +1,179 @@
+package dataset
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	"goraph/graph/digraph/simpledigraph/csr"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// LoadMatrixMarket loads a sparse matrix in Matrix Market coordinate format
+// (possibly gzip-compressed) from r as a simpledigraph.SimpleDigraph with
+// vertices labeled from 1 to max(rows, columns) and edge (i, j) for every
+// stored entry (i, j). Symmetric, skew-symmetric and hermitian matrices
+// also get edge (j, i). Values are ignored.
+//
+// Edges are streamed into csr.NewCSRSimpleDigraphFromSeq, so they are never
+// held in a set.Set.
+//
+// https://math.nist.gov/MatrixMarket/formats.html
+func LoadMatrixMarket(r io.Reader, options Options) (simpleDigraph simpledigraph.SimpleDigraph[int], err error) {
+	reader, err := decompressed(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeReader(reader, &simpleDigraph, &err)
+
+	scanner := bufio.NewScanner(reader)
+	lineNumber := 0
+
+	if !scanner.Scan() {
+		return nil, errors.Join(errors.New("header is missing"), scanner.Err())
+	}
+
+	lineNumber++
+
+	header := strings.Fields(strings.ToLower(scanner.Text()))
+
+	if len(header) != 5 || header[0] != "%%matrixmarket" || header[1] != "matrix" {
+		return nil, lineError(lineNumber, errors.New("expected '%%MatrixMarket matrix <format> <field> <symmetry>'"))
+	}
+
+	if header[2] != "coordinate" {
+		return nil, lineError(lineNumber, fmt.Errorf("format %q is not supported", header[2]))
+	}
+
+	symmetric := header[4] != "general"
+
+	// size line
+
+	var sizeFields []string
+
+	for scanner.Scan() {
+		lineNumber++
+
+		if fields := strings.Fields(scanner.Text()); len(fields) > 0 && !strings.HasPrefix(fields[0], "%") {
+			sizeFields = fields
+
+			break
+		}
+	}
+
+	if len(sizeFields) != 3 {
+		return nil, lineError(lineNumber, errors.New("expected '<rows> <columns> <entries>'"))
+	}
+
+	size := make([]int, len(sizeFields))
+
+	for i, field := range sizeFields {
+		if size[i], err = strconv.Atoi(field); err != nil || size[i] < 0 {
+			return nil, lineError(lineNumber, fmt.Errorf("%q is not a non-negative integer", field))
+		}
+	}
+
+	amountOfVertices := max(size[0], size[1])
+	vertices := mapset.New[int]()
+
+	for vertex := 1; vertex <= amountOfVertices; vertex++ {
+		vertices.Add(vertex)
+	}
+
+	// entries are streamed, first error stops the stream
+
+	var entriesErr error
+	amountOfEntries := 0
+
+	entries := func(yield func(graph.Edge[int]) bool) {
+		for scanner.Scan() {
+			lineNumber++
+
+			fields := strings.Fields(scanner.Text())
+
+			if len(fields) == 0 || strings.HasPrefix(fields[0], "%") {
+				continue
+			}
+
+			amountOfEntries++
+
+			edges, err := matrixMarketEdges(fields, size, symmetric, options)
+
+			if err != nil {
+				entriesErr = lineError(lineNumber, err)
+
+				return
+			}
+
+			for _, edge := range edges {
+				if !yield(edge) {
+					return
+				}
+			}
+		}
+
+		entriesErr = scanner.Err()
+	}
+
+	simpleDigraph, err = csr.NewCSRSimpleDigraphFromSeq(vertices, entries)
+
+	if entriesErr != nil {
+		return nil, entriesErr
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if amountOfEntries != size[2] {
+		return nil, fmt.Errorf("expected %d entries, got %d", size[2], amountOfEntries)
+	}
+
+	return simpleDigraph, nil
+}
+
+func matrixMarketEdges(
+	fields []string,
+	size []int,
+	symmetric bool,
+	options Options,
+) ([]graph.Edge[int], error) {
+	if len(fields) < 2 {
+		return nil, errors.New("expected '<row> <column> [value...]'")
+	}
+
+	i, err := parseVertex(fields[0])
+
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := parseVertex(fields[1])
+
+	if err != nil {
+		return nil, err
+	}
+
+	if i < 1 || i > size[0] || j < 1 || j > size[1] {
+		return nil, fmt.Errorf("entry (%d, %d) is out of bounds", i, j)
+	}
+
+	ij := graph.NewEdge(i, j)
+
+	if isAccepted, err := acceptEdge(ij, options); !isAccepted {
+		return nil, err
+	}
+
+	if symmetric {
+		return []graph.Edge[int]{ij, graph.NewEdge(j, i)}, nil
+	}
+
+	return []graph.Edge[int]{ij}, nil
+}
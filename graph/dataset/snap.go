@@ -0,0 +1,87 @@
+package dataset
+
+import (
+	"bufio"
+	"errors"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	"goraph/graph/digraph/simpledigraph/csr"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// LoadSNAP loads a SNAP edge list (possibly gzip-compressed) from r as
+// a simpledigraph.SimpleDigraph. Every line is either a comment starting
+// with '#' or "<source> <target>" separated by whitespace. Vertices are all
+// vertices that occur in edges, duplicate edges are added only once.
+//
+// Edges are held in a slice and then passed to csr.NewCSRSimpleDigraphFromSeq,
+// so they are never held in a set.Set.
+//
+// https://snap.stanford.edu/data/
+func LoadSNAP(r io.Reader, options Options) (simpleDigraph simpledigraph.SimpleDigraph[int], err error) {
+	reader, err := decompressed(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeReader(reader, &simpleDigraph, &err)
+
+	scanner := bufio.NewScanner(reader)
+	lineNumber := 0
+
+	vertices := mapset.New[int]()
+	edges := make([]graph.Edge[int], 0)
+
+	for scanner.Scan() {
+		lineNumber++
+
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		if len(fields) != 2 {
+			return nil, lineError(lineNumber, errors.New("expected '<source> <target>'"))
+		}
+
+		u, err := parseVertex(fields[0])
+
+		if err != nil {
+			return nil, lineError(lineNumber, err)
+		}
+
+		v, err := parseVertex(fields[1])
+
+		if err != nil {
+			return nil, lineError(lineNumber, err)
+		}
+
+		uv := graph.NewEdge(u, v)
+
+		// a dropped loop still adds its vertex
+		vertices.Add(u)
+		vertices.Add(v)
+
+		isAccepted, err := acceptEdge(uv, options)
+
+		if err != nil {
+			return nil, lineError(lineNumber, err)
+		}
+
+		if isAccepted {
+			edges = append(edges, uv)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return csr.NewCSRSimpleDigraphFromSeq(vertices, slices.Values(edges))
+}
@@ -1,7 +1,16 @@
+// Package digraph provides Digraph interface with its views and
+// IndexedDigraph.
+//
+// goraph has no undirected graph type, so algorithms on undirected graphs
+// take the underlying undirected graph of Digraph: every edge (u, v)
+// connects u and v regardless of its direction, and (u, v) together with
+// (v, u) is a single undirected edge. So an undirected graph may be given
+// with one or both edges for every undirected edge.
 package digraph
 
 import (
 	"goraph/graph"
+	"iter"
 
 	"github.com/nikolai-kramskoy/go-data-structures/set"
 )
@@ -32,4 +41,40 @@ type Digraph[V graph.Vertex] interface {
 	//
 	// No operation on the returned set.Set may affect the state of this Digraph.
 	Predecessors(vertex V) set.Set[V]
+
+	// AllVertices returns an iter.Seq over all vertices in this Digraph.
+	//
+	// Unlike Vertices, it doesn't allocate a new set.Set.
+	AllVertices() iter.Seq[V]
+
+	// AllEdges returns an iter.Seq over all edges in this Digraph.
+	//
+	// Unlike Edges, it doesn't allocate a new set.Set.
+	AllEdges() iter.Seq[graph.Edge[V]]
+
+	// SuccessorsSeq returns an iter.Seq over the same vertices as Successors.
+	//
+	// Unlike Successors, it doesn't allocate a new set.Set.
+	SuccessorsSeq(vertex V) iter.Seq[V]
+
+	// PredecessorsSeq returns an iter.Seq over the same vertices as Predecessors.
+	//
+	// Unlike Predecessors, it doesn't allocate a new set.Set.
+	PredecessorsSeq(vertex V) iter.Seq[V]
+
+	// OutDegree returns the amount of edges with vertex source in this Digraph.
+	//
+	// It returns 0 if vertex is not present in this Digraph.
+	OutDegree(vertex V) int
+
+	// InDegree returns the amount of edges with vertex target in this Digraph.
+	//
+	// It returns 0 if vertex is not present in this Digraph.
+	InDegree(vertex V) int
+
+	// Order returns the amount of vertices in this Digraph.
+	Order() int
+
+	// Size returns the amount of edges in this Digraph.
+	Size() int
 }
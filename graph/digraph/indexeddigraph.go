@@ -0,0 +1,95 @@
+package digraph
+
+import (
+	"goraph/graph"
+	"slices"
+)
+
+// IndexedDigraph is an int-indexed snapshot of Digraph: every vertex is
+// replaced by its graph.Indexer index and adjacency is stored in slices,
+// so algorithms can use slices for visited sets, distances and predecessors
+// and translate results back to vertices with graph.Indexer.
+//
+// This implementation is immutable and thread-safe.
+type IndexedDigraph[V graph.Vertex] struct {
+	indexer      *graph.Indexer[V]
+	successors   [][]int
+	predecessors [][]int
+	size         int
+}
+
+// NewIndexedDigraph creates an IndexedDigraph of digraph.
+//
+// Neighbor indices of every vertex are sorted in ascending order.
+func NewIndexedDigraph[V graph.Vertex](digraph Digraph[V]) *IndexedDigraph[V] {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+
+	indexer := graph.NewIndexer(digraph.AllVertices())
+	successors := make([][]int, indexer.Len())
+	predecessors := make([][]int, indexer.Len())
+
+	for u := 0; u < indexer.Len(); u++ {
+		vertex := indexer.Vertex(u)
+
+		successors[u] = make([]int, 0, digraph.OutDegree(vertex))
+		predecessors[u] = make([]int, 0, digraph.InDegree(vertex))
+	}
+
+	size := 0
+
+	for edge := range digraph.AllEdges() {
+		u, _ := indexer.Index(edge.Source())
+		v, _ := indexer.Index(edge.Target())
+
+		successors[u] = append(successors[u], v)
+		predecessors[v] = append(predecessors[v], u)
+		size++
+	}
+
+	for u := 0; u < indexer.Len(); u++ {
+		slices.Sort(successors[u])
+		slices.Sort(predecessors[u])
+	}
+
+	return &IndexedDigraph[V]{indexer, successors, predecessors, size}
+}
+
+// Indexer returns graph.Indexer that maps vertices of the original Digraph
+// to indices of this IndexedDigraph.
+func (digraph *IndexedDigraph[V]) Indexer() *graph.Indexer[V] {
+	return digraph.indexer
+}
+
+// Successors returns indices of successors of vertex with index u.
+//
+// The returned slice must not be modified.
+func (digraph *IndexedDigraph[V]) Successors(u int) []int {
+	return digraph.successors[u]
+}
+
+// Predecessors returns indices of predecessors of vertex with index v.
+//
+// The returned slice must not be modified.
+func (digraph *IndexedDigraph[V]) Predecessors(v int) []int {
+	return digraph.predecessors[v]
+}
+
+// HasEdge returns true iff edge from vertex with index u to vertex
+// with index v exists in this IndexedDigraph.
+func (digraph *IndexedDigraph[V]) HasEdge(u, v int) bool {
+	_, isFound := slices.BinarySearch(digraph.successors[u], v)
+
+	return isFound
+}
+
+// Order returns the amount of vertices in this IndexedDigraph.
+func (digraph *IndexedDigraph[V]) Order() int {
+	return len(digraph.successors)
+}
+
+// Size returns the amount of edges in this IndexedDigraph.
+func (digraph *IndexedDigraph[V]) Size() int {
+	return digraph.size
+}
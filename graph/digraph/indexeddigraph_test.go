@@ -0,0 +1,44 @@
+package digraph_test
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexedDigraph(t *testing.T) {
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4),
+		mapset.NewFromElements(
+			graph.NewEdge(1, 2),
+			graph.NewEdge(1, 4),
+			graph.NewEdge(2, 1),
+			graph.NewEdge(2, 3),
+			graph.NewEdge(3, 4),
+		),
+	)
+
+	indexedDigraph := digraph.NewIndexedDigraph(simpleDigraph)
+	indexer := indexedDigraph.Indexer()
+
+	assert.Equal(t, 4, indexedDigraph.Order())
+	assert.Equal(t, 5, indexedDigraph.Size())
+
+	for u := 0; u < indexedDigraph.Order(); u++ {
+		source := indexer.Vertex(u)
+
+		assert.Equal(t, simpleDigraph.OutDegree(source), len(indexedDigraph.Successors(u)))
+		assert.Equal(t, simpleDigraph.InDegree(source), len(indexedDigraph.Predecessors(u)))
+
+		for v := 0; v < indexedDigraph.Order(); v++ {
+			target := indexer.Vertex(v)
+
+			assert.Equal(t, simpleDigraph.Edge(source, target) != nil, indexedDigraph.HasEdge(u, v))
+		}
+	}
+}
@@ -0,0 +1,246 @@
+// Package ops provides set-style operations on simpledigraph.SimpleDigraph.
+//
+// Every operation creates a new immutable simpledigraph.SimpleDigraph
+// using adjacency list ADT and never mutates its arguments.
+//
+// https://en.wikipedia.org/wiki/Graph_operations
+package ops
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set"
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// Union creates a simpledigraph.SimpleDigraph which vertices and edges are
+// unions of vertices and edges of g and h.
+func Union[V graph.Vertex](g, h simpledigraph.SimpleDigraph[V]) simpledigraph.SimpleDigraph[V] {
+	assertNotNil(g, h)
+
+	vertices := g.Vertices()
+	edges := g.Edges()
+
+	for vertex := range h.AllVertices() {
+		vertices.Add(vertex)
+	}
+
+	for edge := range h.AllEdges() {
+		edges.Add(edge)
+	}
+
+	return newSimpleDigraph(vertices, edges)
+}
+
+// Intersection creates a simpledigraph.SimpleDigraph which vertices and edges
+// are intersections of vertices and edges of g and h.
+func Intersection[V graph.Vertex](g, h simpledigraph.SimpleDigraph[V]) simpledigraph.SimpleDigraph[V] {
+	assertNotNil(g, h)
+
+	hVertices := h.Vertices()
+	vertices := mapset.New[V]()
+	edges := mapset.New[graph.Edge[V]]()
+
+	for vertex := range g.AllVertices() {
+		if hVertices.Contains(vertex) {
+			vertices.Add(vertex)
+		}
+	}
+
+	for edge := range g.AllEdges() {
+		if h.Edge(edge.Source(), edge.Target()) != nil {
+			edges.Add(edge)
+		}
+	}
+
+	return newSimpleDigraph(vertices, edges)
+}
+
+// Difference creates a simpledigraph.SimpleDigraph with all vertices of g
+// and edges of g that are not present in h.
+func Difference[V graph.Vertex](g, h simpledigraph.SimpleDigraph[V]) simpledigraph.SimpleDigraph[V] {
+	assertNotNil(g, h)
+
+	edges := mapset.New[graph.Edge[V]]()
+
+	for edge := range g.AllEdges() {
+		if h.Edge(edge.Source(), edge.Target()) == nil {
+			edges.Add(edge)
+		}
+	}
+
+	return newSimpleDigraph(g.Vertices(), edges)
+}
+
+// Complement creates a simpledigraph.SimpleDigraph with all vertices of g
+// and edge (u, v) for every u != v iff (u, v) is not present in g.
+//
+// https://en.wikipedia.org/wiki/Complement_graph
+func Complement[V graph.Vertex](g simpledigraph.SimpleDigraph[V]) simpledigraph.SimpleDigraph[V] {
+	assertNotNil(g)
+
+	vertices := g.Vertices()
+	edges := mapset.New[graph.Edge[V]]()
+
+	for u := range g.AllVertices() {
+		for v := range g.AllVertices() {
+			if u != v && g.Edge(u, v) == nil {
+				edges.Add(graph.NewEdge(u, v))
+			}
+		}
+	}
+
+	return newSimpleDigraph(vertices, edges)
+}
+
+// LineDigraph creates a simpledigraph.SimpleDigraph which vertices are
+// edges of g and which has edge ((u, v), (v, w)) for every pair of
+// edges (u, v) and (v, w) of g.
+//
+// https://en.wikipedia.org/wiki/Line_graph#Line_digraphs
+func LineDigraph[V graph.Vertex](
+	g simpledigraph.SimpleDigraph[V],
+) simpledigraph.SimpleDigraph[graph.Edge[V]] {
+	assertNotNil(g)
+
+	vertices := g.Edges()
+	edges := mapset.New[graph.Edge[graph.Edge[V]]]()
+
+	for uv := range g.AllEdges() {
+		for w := range g.SuccessorsSeq(uv.Target()) {
+			edges.Add(graph.NewEdge(uv, graph.NewEdge(uv.Target(), w)))
+		}
+	}
+
+	return newSimpleDigraph(vertices, edges)
+}
+
+// CartesianProduct creates a simpledigraph.SimpleDigraph which vertices are
+// all pairs (u, v) of vertices of g and h and which has edge
+// ((u, v), (u', v')) iff u == u' and (v, v') is an edge of h or
+// v == v' and (u, u') is an edge of g.
+//
+// https://en.wikipedia.org/wiki/Cartesian_product_of_graphs
+func CartesianProduct[V, W graph.Vertex](
+	g simpledigraph.SimpleDigraph[V],
+	h simpledigraph.SimpleDigraph[W],
+) simpledigraph.SimpleDigraph[Pair[V, W]] {
+	assertNotNil(g)
+	assertNotNil(h)
+
+	edges := mapset.New[graph.Edge[Pair[V, W]]]()
+
+	addCartesianEdges(g, h, edges)
+
+	return newSimpleDigraph(pairs(g, h), edges)
+}
+
+// TensorProduct creates a simpledigraph.SimpleDigraph which vertices are
+// all pairs (u, v) of vertices of g and h and which has edge
+// ((u, v), (u', v')) iff (u, u') is an edge of g and (v, v') is an edge of h.
+//
+// https://en.wikipedia.org/wiki/Tensor_product_of_graphs
+func TensorProduct[V, W graph.Vertex](
+	g simpledigraph.SimpleDigraph[V],
+	h simpledigraph.SimpleDigraph[W],
+) simpledigraph.SimpleDigraph[Pair[V, W]] {
+	assertNotNil(g)
+	assertNotNil(h)
+
+	edges := mapset.New[graph.Edge[Pair[V, W]]]()
+
+	addTensorEdges(g, h, edges)
+
+	return newSimpleDigraph(pairs(g, h), edges)
+}
+
+// StrongProduct creates a simpledigraph.SimpleDigraph which edges are
+// the union of edges of CartesianProduct and TensorProduct of g and h.
+//
+// https://en.wikipedia.org/wiki/Strong_product_of_graphs
+func StrongProduct[V, W graph.Vertex](
+	g simpledigraph.SimpleDigraph[V],
+	h simpledigraph.SimpleDigraph[W],
+) simpledigraph.SimpleDigraph[Pair[V, W]] {
+	assertNotNil(g)
+	assertNotNil(h)
+
+	edges := mapset.New[graph.Edge[Pair[V, W]]]()
+
+	addCartesianEdges(g, h, edges)
+	addTensorEdges(g, h, edges)
+
+	return newSimpleDigraph(pairs(g, h), edges)
+}
+
+func pairs[V, W graph.Vertex](
+	g simpledigraph.SimpleDigraph[V],
+	h simpledigraph.SimpleDigraph[W],
+) set.Set[Pair[V, W]] {
+	vertices := mapset.New[Pair[V, W]]()
+
+	for u := range g.AllVertices() {
+		for v := range h.AllVertices() {
+			vertices.Add(NewPair(u, v))
+		}
+	}
+
+	return vertices
+}
+
+func addCartesianEdges[V, W graph.Vertex](
+	g simpledigraph.SimpleDigraph[V],
+	h simpledigraph.SimpleDigraph[W],
+	edges set.Set[graph.Edge[Pair[V, W]]],
+) {
+	for u := range g.AllVertices() {
+		for vv := range h.AllEdges() {
+			edges.Add(graph.NewEdge(NewPair(u, vv.Source()), NewPair(u, vv.Target())))
+		}
+	}
+
+	for uu := range g.AllEdges() {
+		for v := range h.AllVertices() {
+			edges.Add(graph.NewEdge(NewPair(uu.Source(), v), NewPair(uu.Target(), v)))
+		}
+	}
+}
+
+func addTensorEdges[V, W graph.Vertex](
+	g simpledigraph.SimpleDigraph[V],
+	h simpledigraph.SimpleDigraph[W],
+	edges set.Set[graph.Edge[Pair[V, W]]],
+) {
+	for uu := range g.AllEdges() {
+		for vv := range h.AllEdges() {
+			edges.Add(graph.NewEdge(
+				NewPair(uu.Source(), vv.Source()),
+				NewPair(uu.Target(), vv.Target()),
+			))
+		}
+	}
+}
+
+func newSimpleDigraph[V graph.Vertex](
+	vertices set.Set[V],
+	edges set.Set[graph.Edge[V]],
+) simpledigraph.SimpleDigraph[V] {
+	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	// vertices and edges are always consistent here
+	if err != nil {
+		panic(err)
+	}
+
+	return simpleDigraph
+}
+
+func assertNotNil[V graph.Vertex](simpleDigraphs ...simpledigraph.SimpleDigraph[V]) {
+	for _, simpleDigraph := range simpleDigraphs {
+		if simpleDigraph == nil {
+			panic("simpleDigraph == nil")
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package ops
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSimpleDigraphs() (simpledigraph.SimpleDigraph[int], simpledigraph.SimpleDigraph[int]) {
+	g, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3),
+		mapset.NewFromElements(graph.NewEdge(1, 2), graph.NewEdge(2, 3)),
+	)
+
+	h, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(2, 3, 4),
+		mapset.NewFromElements(graph.NewEdge(2, 3), graph.NewEdge(3, 4)),
+	)
+
+	return g, h
+}
+
+func TestUnion(t *testing.T) {
+	g, h := newSimpleDigraphs()
+
+	union := Union(g, h)
+
+	assert.Equal(t, mapset.NewFromElements(1, 2, 3, 4), union.Vertices())
+	assert.Equal(
+		t,
+		mapset.NewFromElements(graph.NewEdge(1, 2), graph.NewEdge(2, 3), graph.NewEdge(3, 4)),
+		union.Edges(),
+	)
+}
+
+func TestIntersection(t *testing.T) {
+	g, h := newSimpleDigraphs()
+
+	intersection := Intersection(g, h)
+
+	assert.Equal(t, mapset.NewFromElements(2, 3), intersection.Vertices())
+	assert.Equal(t, mapset.NewFromElements(graph.NewEdge(2, 3)), intersection.Edges())
+}
+
+func TestDifference(t *testing.T) {
+	g, h := newSimpleDigraphs()
+
+	difference := Difference(g, h)
+
+	assert.Equal(t, mapset.NewFromElements(1, 2, 3), difference.Vertices())
+	assert.Equal(t, mapset.NewFromElements(graph.NewEdge(1, 2)), difference.Edges())
+}
+
+func TestComplement(t *testing.T) {
+	g, _ := newSimpleDigraphs()
+
+	complement := Complement(g)
+
+	assert.Equal(t, mapset.NewFromElements(1, 2, 3), complement.Vertices())
+	assert.Equal(
+		t,
+		mapset.NewFromElements(
+			graph.NewEdge(1, 3),
+			graph.NewEdge(2, 1),
+			graph.NewEdge(3, 1),
+			graph.NewEdge(3, 2),
+		),
+		complement.Edges(),
+	)
+}
+
+func TestLineDigraph(t *testing.T) {
+	g, _ := newSimpleDigraphs()
+
+	lineDigraph := LineDigraph(g)
+
+	oneTwo, twoThree := graph.NewEdge(1, 2), graph.NewEdge(2, 3)
+
+	assert.Equal(t, mapset.NewFromElements(oneTwo, twoThree), lineDigraph.Vertices())
+	assert.Equal(t, mapset.NewFromElements(graph.NewEdge(oneTwo, twoThree)), lineDigraph.Edges())
+}
+
+func newArc() simpledigraph.SimpleDigraph[string] {
+	arc, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements("a", "b"),
+		mapset.NewFromElements(graph.NewEdge("a", "b")),
+	)
+
+	return arc
+}
+
+func TestCartesianProduct(t *testing.T) {
+	product := CartesianProduct(newArc(), newArc())
+
+	aa, ab, ba, bb := NewPair("a", "a"), NewPair("a", "b"), NewPair("b", "a"), NewPair("b", "b")
+
+	assert.Equal(t, mapset.NewFromElements(aa, ab, ba, bb), product.Vertices())
+	assert.Equal(
+		t,
+		mapset.NewFromElements(
+			graph.NewEdge(aa, ab),
+			graph.NewEdge(ba, bb),
+			graph.NewEdge(aa, ba),
+			graph.NewEdge(ab, bb),
+		),
+		product.Edges(),
+	)
+}
+
+func TestTensorProduct(t *testing.T) {
+	product := TensorProduct(newArc(), newArc())
+
+	aa, ab, ba, bb := NewPair("a", "a"), NewPair("a", "b"), NewPair("b", "a"), NewPair("b", "b")
+
+	assert.Equal(t, mapset.NewFromElements(aa, ab, ba, bb), product.Vertices())
+	assert.Equal(t, mapset.NewFromElements(graph.NewEdge(aa, bb)), product.Edges())
+}
+
+func TestStrongProduct(t *testing.T) {
+	product := StrongProduct(newArc(), newArc())
+
+	assert.Equal(t, 4, product.Order())
+	assert.Equal(t, 5, product.Size())
+	assert.NotNil(t, product.Edge(NewPair("a", "a"), NewPair("b", "b")))
+}
@@ -0,0 +1,17 @@
+package ops
+
+import "goraph/graph"
+
+// Pair struct represents a vertex of a graph product, i.e. a pair of
+// vertices of its factors.
+//
+// Pair is comparable, so it satisfies graph.Vertex.
+type Pair[V, W graph.Vertex] struct {
+	First  V
+	Second W
+}
+
+// NewPair creates a Pair.
+func NewPair[V, W graph.Vertex](first V, second W) Pair[V, W] {
+	return Pair[V, W]{first, second}
+}
@@ -5,14 +5,17 @@ import (
 	"fmt"
 	"goraph/graph"
 	"goraph/graph/digraph/simpledigraph"
+	"iter"
+	"maps"
 
 	"github.com/nikolai-kramskoy/go-data-structures/set"
 	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
 )
 
 type adjacencyListSimpleDigraph[V graph.Vertex] struct {
-	successors   map[V]set.Set[V]
-	predecessors map[V]set.Set[V]
+	successors   map[V]map[V]struct{}
+	predecessors map[V]map[V]struct{}
+	size         int
 }
 
 var _ simpledigraph.SimpleDigraph[struct{}] = (*adjacencyListSimpleDigraph[struct{}])(nil)
@@ -34,12 +37,12 @@ func NewAdjacencyListSimpleDigraph[V graph.Vertex](
 		return nil, errors.New("edges == nil")
 	}
 
-	successors := make(map[V]set.Set[V], vertices.Size())
-	predecessors := make(map[V]set.Set[V], vertices.Size())
+	successors := make(map[V]map[V]struct{}, vertices.Size())
+	predecessors := make(map[V]map[V]struct{}, vertices.Size())
 
 	for _, vertex := range vertices.Elements() {
-		successors[vertex] = mapset.New[V]()
-		predecessors[vertex] = mapset.New[V]()
+		successors[vertex] = make(map[V]struct{})
+		predecessors[vertex] = make(map[V]struct{})
 	}
 
 	for _, edge := range edges.Elements() {
@@ -64,32 +67,28 @@ func NewAdjacencyListSimpleDigraph[V graph.Vertex](
 			return nil, err
 		}
 
-		successors[u].Add(v)
-		predecessors[v].Add(u)
+		successors[u][v] = struct{}{}
+		predecessors[v][u] = struct{}{}
 	}
 
-	return &adjacencyListSimpleDigraph[V]{successors, predecessors}, nil
+	return &adjacencyListSimpleDigraph[V]{successors, predecessors, edges.Size()}, nil
 }
 
 func (digraph *adjacencyListSimpleDigraph[V]) Vertices() set.Set[V] {
-	vertices := make([]V, len(digraph.successors))
+	vertices := make([]V, 0, len(digraph.successors))
 
-	i := 0
-	for vertex := range digraph.successors {
-		vertices[i] = vertex
-		i++
+	for vertex := range digraph.AllVertices() {
+		vertices = append(vertices, vertex)
 	}
 
 	return mapset.NewFromElements(vertices...)
 }
 
 func (digraph *adjacencyListSimpleDigraph[V]) Edges() set.Set[graph.Edge[V]] {
-	edges := make([]graph.Edge[V], 0)
+	edges := make([]graph.Edge[V], 0, digraph.size)
 
-	for vertex, successors := range digraph.successors {
-		for _, successor := range successors.Elements() {
-			edges = append(edges, graph.NewEdge(vertex, successor))
-		}
+	for edge := range digraph.AllEdges() {
+		edges = append(edges, edge)
 	}
 
 	return mapset.NewFromElements(edges...)
@@ -98,12 +97,8 @@ func (digraph *adjacencyListSimpleDigraph[V]) Edges() set.Set[graph.Edge[V]] {
 func (digraph *adjacencyListSimpleDigraph[V]) Successors(vertex V) set.Set[V] {
 	successors := mapset.New[V]()
 
-	if _, isPresent := digraph.successors[vertex]; !isPresent {
-		return successors
-	}
-
-	for _, vertexSuccessor := range digraph.successors[vertex].Elements() {
-		successors.Add(vertexSuccessor)
+	for successor := range digraph.SuccessorsSeq(vertex) {
+		successors.Add(successor)
 	}
 
 	return successors
@@ -112,34 +107,64 @@ func (digraph *adjacencyListSimpleDigraph[V]) Successors(vertex V) set.Set[V] {
 func (digraph *adjacencyListSimpleDigraph[V]) Predecessors(vertex V) set.Set[V] {
 	predecessors := mapset.New[V]()
 
-	if _, isPresent := digraph.predecessors[vertex]; !isPresent {
-		return predecessors
+	for predecessor := range digraph.PredecessorsSeq(vertex) {
+		predecessors.Add(predecessor)
 	}
 
-	for _, vertexPredecessor := range digraph.predecessors[vertex].Elements() {
-		predecessors.Add(vertexPredecessor)
+	return predecessors
+}
+
+func (digraph *adjacencyListSimpleDigraph[V]) AllVertices() iter.Seq[V] {
+	return maps.Keys(digraph.successors)
+}
+
+func (digraph *adjacencyListSimpleDigraph[V]) AllEdges() iter.Seq[graph.Edge[V]] {
+	return func(yield func(graph.Edge[V]) bool) {
+		for vertex, successors := range digraph.successors {
+			for successor := range successors {
+				if !yield(graph.NewEdge(vertex, successor)) {
+					return
+				}
+			}
+		}
 	}
+}
 
-	return predecessors
+func (digraph *adjacencyListSimpleDigraph[V]) SuccessorsSeq(vertex V) iter.Seq[V] {
+	// keys of nil map is an empty iter.Seq
+	return maps.Keys(digraph.successors[vertex])
+}
+
+func (digraph *adjacencyListSimpleDigraph[V]) PredecessorsSeq(vertex V) iter.Seq[V] {
+	// keys of nil map is an empty iter.Seq
+	return maps.Keys(digraph.predecessors[vertex])
+}
+
+func (digraph *adjacencyListSimpleDigraph[V]) OutDegree(vertex V) int {
+	return len(digraph.successors[vertex])
+}
+
+func (digraph *adjacencyListSimpleDigraph[V]) InDegree(vertex V) int {
+	return len(digraph.predecessors[vertex])
+}
+
+func (digraph *adjacencyListSimpleDigraph[V]) Order() int {
+	return len(digraph.successors)
+}
+
+func (digraph *adjacencyListSimpleDigraph[V]) Size() int {
+	return digraph.size
 }
 
 func (digraph *adjacencyListSimpleDigraph[V]) Edge(
 	source V,
 	target V,
 ) *graph.Edge[V] {
-	_, sourceIsPresent := digraph.successors[source]
-	_, targetIsPresent := digraph.successors[target]
-
-	if !sourceIsPresent || !targetIsPresent {
+	if _, isPresent := digraph.successors[source][target]; !isPresent {
 		return nil
 	}
 
-	for _, sourceSuccessor := range digraph.successors[source].Elements() {
-		if sourceSuccessor == target {
-			edge := graph.NewEdge(source, target)
-			return &edge
-		}
-	}
+	edge := graph.NewEdge(source, target)
 
-	return nil
+	return &edge
 }
@@ -2,6 +2,7 @@ package adjacencylist
 
 import (
 	"goraph/graph"
+	"slices"
 	"testing"
 
 	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
@@ -81,3 +82,33 @@ func TestAdjacencyListSimpleDigraph_Predecessor2(t *testing.T) {
 
 	assert.Equal(t, mapset.New[int](), twoPredecessors)
 }
+
+func TestAdjacencyListSimpleDigraph_Seq(t *testing.T) {
+	simpleDigraph, err := NewAdjacencyListSimpleDigraph(vertices, edges)
+	assert.NotNil(t, simpleDigraph)
+	assert.Nil(t, err)
+
+	assert.Equal(t, vertices, mapset.NewFromElements(slices.Collect(simpleDigraph.AllVertices())...))
+	assert.Equal(t, edges, mapset.NewFromElements(slices.Collect(simpleDigraph.AllEdges())...))
+
+	assert.ElementsMatch(t, []int{1, 3, 4}, slices.Collect(simpleDigraph.SuccessorsSeq(2)))
+	assert.ElementsMatch(t, []int{1, 2, 3}, slices.Collect(simpleDigraph.PredecessorsSeq(4)))
+	assert.Empty(t, slices.Collect(simpleDigraph.SuccessorsSeq(-2)))
+	assert.Empty(t, slices.Collect(simpleDigraph.PredecessorsSeq(-2)))
+}
+
+func TestAdjacencyListSimpleDigraph_Counts(t *testing.T) {
+	simpleDigraph, err := NewAdjacencyListSimpleDigraph(vertices, edges)
+	assert.NotNil(t, simpleDigraph)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 4, simpleDigraph.Order())
+	assert.Equal(t, 6, simpleDigraph.Size())
+
+	assert.Equal(t, 3, simpleDigraph.OutDegree(2))
+	assert.Equal(t, 1, simpleDigraph.InDegree(2))
+	assert.Equal(t, 0, simpleDigraph.OutDegree(4))
+	assert.Equal(t, 3, simpleDigraph.InDegree(4))
+	assert.Equal(t, 0, simpleDigraph.OutDegree(-2))
+	assert.Equal(t, 0, simpleDigraph.InDegree(-2))
+}
@@ -0,0 +1,363 @@
+package csr
+
+import (
+	"errors"
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	"iter"
+	"math"
+	"slices"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set"
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// vertexId is an interned integer identifier of graph.Vertex.
+type vertexId = int32
+
+// maxAmountOfVertices is the amount of vertices that vertexId can identify.
+const maxAmountOfVertices = math.MaxInt32
+
+type csrSimpleDigraph[V graph.Vertex] struct {
+	// vertexToId and idToVertex intern every graph.Vertex to vertexId
+	// from 0 to len(idToVertex) - 1.
+	vertexToId map[V]vertexId
+	idToVertex []V
+
+	// successors of vertex with id u are
+	// successorIds[successorOffsets[u]:successorOffsets[u+1]],
+	// sorted in ascending order.
+	successorOffsets []int
+	successorIds     []vertexId
+
+	// predecessors of vertex with id v are
+	// predecessorIds[predecessorOffsets[v]:predecessorOffsets[v+1]],
+	// sorted in ascending order.
+	predecessorOffsets []int
+	predecessorIds     []vertexId
+}
+
+var _ simpledigraph.SimpleDigraph[struct{}] = (*csrSimpleDigraph[struct{}])(nil)
+
+// NewCSRSimpleDigraph creates an immutable simpledigraph.SimpleDigraph
+// implementation using compressed sparse row (CSR) representation.
+//
+// It uses much less memory than adjacency list for large graphs, so it is
+// suited for large read-only graphs. Vertices are interned to int32 IDs,
+// so there may be at most math.MaxInt32 of them.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Sparse_matrix#Compressed_sparse_row_(CSR,_CRS_or_Yale_format)
+func NewCSRSimpleDigraph[V graph.Vertex](
+	vertices set.Set[V],
+	edges set.Set[graph.Edge[V]],
+) (simpledigraph.SimpleDigraph[V], error) {
+	if vertices == nil {
+		return nil, errors.New("vertices == nil")
+	}
+	if edges == nil {
+		return nil, errors.New("edges == nil")
+	}
+
+	return NewCSRSimpleDigraphFromSeq(vertices, slices.Values(edges.Elements()))
+}
+
+// NewCSRSimpleDigraphFromSeq creates the same simpledigraph.SimpleDigraph
+// as NewCSRSimpleDigraph, but edges are streamed from edges iter.Seq,
+// so the caller doesn't need to hold all of them in set.Set.
+//
+// Duplicate edges in edges iter.Seq are added only once.
+func NewCSRSimpleDigraphFromSeq[V graph.Vertex](
+	vertices set.Set[V],
+	edges iter.Seq[graph.Edge[V]],
+) (simpledigraph.SimpleDigraph[V], error) {
+	if vertices == nil {
+		return nil, errors.New("vertices == nil")
+	}
+	if edges == nil {
+		return nil, errors.New("edges == nil")
+	}
+
+	return newCSRSimpleDigraph(vertices.Elements(), edges)
+}
+
+// NewOrderedCSRSimpleDigraph creates the same simpledigraph.SimpleDigraph
+// as NewCSRSimpleDigraphFromSeq, but vertices are given in order, which
+// makes the iteration order deterministic: AllVertices yields vertices in
+// that order, AllEdges yields edges ordered by source and then by target,
+// SuccessorsSeq and PredecessorsSeq yield vertices in that order too.
+//
+// Duplicate vertices are not allowed.
+func NewOrderedCSRSimpleDigraph[V graph.Vertex](
+	vertices []V,
+	edges iter.Seq[graph.Edge[V]],
+) (simpledigraph.SimpleDigraph[V], error) {
+	if vertices == nil {
+		return nil, errors.New("vertices == nil")
+	}
+	if edges == nil {
+		return nil, errors.New("edges == nil")
+	}
+
+	return newCSRSimpleDigraph(slices.Clone(vertices), edges)
+}
+
+// newCSRSimpleDigraph creates csrSimpleDigraph where vertex idToVertex[id]
+// has id.
+func newCSRSimpleDigraph[V graph.Vertex](
+	idToVertex []V,
+	edges iter.Seq[graph.Edge[V]],
+) (simpledigraph.SimpleDigraph[V], error) {
+	if len(idToVertex) > maxAmountOfVertices {
+		err := fmt.Errorf(
+			"too many vertices (%d > %d)",
+			len(idToVertex),
+			maxAmountOfVertices,
+		)
+
+		return nil, err
+	}
+
+	vertexToId := make(map[V]vertexId, len(idToVertex))
+
+	for id, vertex := range idToVertex {
+		if _, isDuplicate := vertexToId[vertex]; isDuplicate {
+			err := fmt.Errorf(
+				"duplicate vertices are not allowed (%+v)",
+				vertex,
+			)
+
+			return nil, err
+		}
+
+		vertexToId[vertex] = vertexId(id)
+	}
+
+	sourceIds := make([]vertexId, 0)
+	targetIds := make([]vertexId, 0)
+
+	for edge := range edges {
+		u := edge.Source()
+		v := edge.Target()
+
+		if u == v {
+			err := fmt.Errorf(
+				"loop edges are not allowed (%+v)",
+				edge,
+			)
+
+			return nil, err
+		}
+
+		uId, uIsPresent := vertexToId[u]
+		vId, vIsPresent := vertexToId[v]
+
+		if !uIsPresent || !vIsPresent {
+			err := fmt.Errorf(
+				"source or target vertex of (%+v) is not present in vertices",
+				edge,
+			)
+
+			return nil, err
+		}
+
+		sourceIds = append(sourceIds, uId)
+		targetIds = append(targetIds, vId)
+	}
+
+	successorOffsets, successorIds := compressSparseRows(len(idToVertex), sourceIds, targetIds)
+	predecessorOffsets, predecessorIds := compressSparseRows(len(idToVertex), targetIds, sourceIds)
+
+	return &csrSimpleDigraph[V]{
+		vertexToId:         vertexToId,
+		idToVertex:         idToVertex,
+		successorOffsets:   successorOffsets,
+		successorIds:       successorIds,
+		predecessorOffsets: predecessorOffsets,
+		predecessorIds:     predecessorIds,
+	}, nil
+}
+
+// compressSparseRows groups columnIds by rowIds using counting sort, then sorts
+// every row and removes duplicate columns from it.
+func compressSparseRows(
+	amountOfRows int,
+	rowIds []vertexId,
+	columnIds []vertexId,
+) ([]int, []vertexId) {
+	offsets := make([]int, amountOfRows+1)
+
+	for _, rowId := range rowIds {
+		offsets[rowId+1]++
+	}
+
+	for row := 0; row < amountOfRows; row++ {
+		offsets[row+1] += offsets[row]
+	}
+
+	nextPositions := slices.Clone(offsets[:amountOfRows])
+	columns := make([]vertexId, len(columnIds))
+
+	for i, rowId := range rowIds {
+		columns[nextPositions[rowId]] = columnIds[i]
+		nextPositions[rowId]++
+	}
+
+	// sort and deduplicate every row in place, shifting rows to the left
+	// if some duplicates were removed
+
+	compactedLen := 0
+
+	for row := 0; row < amountOfRows; row++ {
+		rowColumns := columns[offsets[row]:offsets[row+1]]
+		slices.Sort(rowColumns)
+		rowColumns = slices.Compact(rowColumns)
+
+		offsets[row] = compactedLen
+		compactedLen += copy(columns[compactedLen:], rowColumns)
+	}
+
+	offsets[amountOfRows] = compactedLen
+
+	return offsets, slices.Clip(columns[:compactedLen])
+}
+
+func (digraph *csrSimpleDigraph[V]) Vertices() set.Set[V] {
+	return mapset.NewFromElements(digraph.idToVertex...)
+}
+
+func (digraph *csrSimpleDigraph[V]) Edges() set.Set[graph.Edge[V]] {
+	edges := make([]graph.Edge[V], 0, len(digraph.successorIds))
+
+	for edge := range digraph.AllEdges() {
+		edges = append(edges, edge)
+	}
+
+	return mapset.NewFromElements(edges...)
+}
+
+func (digraph *csrSimpleDigraph[V]) Successors(vertex V) set.Set[V] {
+	successors := mapset.New[V]()
+
+	for successor := range digraph.SuccessorsSeq(vertex) {
+		successors.Add(successor)
+	}
+
+	return successors
+}
+
+func (digraph *csrSimpleDigraph[V]) Predecessors(vertex V) set.Set[V] {
+	predecessors := mapset.New[V]()
+
+	for predecessor := range digraph.PredecessorsSeq(vertex) {
+		predecessors.Add(predecessor)
+	}
+
+	return predecessors
+}
+
+func (digraph *csrSimpleDigraph[V]) AllVertices() iter.Seq[V] {
+	return slices.Values(digraph.idToVertex)
+}
+
+func (digraph *csrSimpleDigraph[V]) AllEdges() iter.Seq[graph.Edge[V]] {
+	return func(yield func(graph.Edge[V]) bool) {
+		for uId, u := range digraph.idToVertex {
+			for _, vId := range digraph.successorIdsOf(vertexId(uId)) {
+				if !yield(graph.NewEdge(u, digraph.idToVertex[vId])) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (digraph *csrSimpleDigraph[V]) SuccessorsSeq(vertex V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		uId, uIsPresent := digraph.vertexToId[vertex]
+
+		if !uIsPresent {
+			return
+		}
+
+		for _, vId := range digraph.successorIdsOf(uId) {
+			if !yield(digraph.idToVertex[vId]) {
+				return
+			}
+		}
+	}
+}
+
+func (digraph *csrSimpleDigraph[V]) PredecessorsSeq(vertex V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		vId, vIsPresent := digraph.vertexToId[vertex]
+
+		if !vIsPresent {
+			return
+		}
+
+		for _, uId := range digraph.predecessorIdsOf(vId) {
+			if !yield(digraph.idToVertex[uId]) {
+				return
+			}
+		}
+	}
+}
+
+func (digraph *csrSimpleDigraph[V]) OutDegree(vertex V) int {
+	uId, uIsPresent := digraph.vertexToId[vertex]
+
+	if !uIsPresent {
+		return 0
+	}
+
+	return len(digraph.successorIdsOf(uId))
+}
+
+func (digraph *csrSimpleDigraph[V]) InDegree(vertex V) int {
+	vId, vIsPresent := digraph.vertexToId[vertex]
+
+	if !vIsPresent {
+		return 0
+	}
+
+	return len(digraph.predecessorIdsOf(vId))
+}
+
+func (digraph *csrSimpleDigraph[V]) Order() int {
+	return len(digraph.idToVertex)
+}
+
+func (digraph *csrSimpleDigraph[V]) Size() int {
+	return len(digraph.successorIds)
+}
+
+func (digraph *csrSimpleDigraph[V]) Edge(
+	source V,
+	target V,
+) *graph.Edge[V] {
+	uId, sourceIsPresent := digraph.vertexToId[source]
+	vId, targetIsPresent := digraph.vertexToId[target]
+
+	if !sourceIsPresent || !targetIsPresent {
+		return nil
+	}
+
+	if _, isFound := slices.BinarySearch(digraph.successorIdsOf(uId), vId); !isFound {
+		return nil
+	}
+
+	edge := graph.NewEdge(source, target)
+
+	return &edge
+}
+
+func (digraph *csrSimpleDigraph[V]) successorIdsOf(uId vertexId) []vertexId {
+	return digraph.successorIds[digraph.successorOffsets[uId]:digraph.successorOffsets[uId+1]]
+}
+
+func (digraph *csrSimpleDigraph[V]) predecessorIdsOf(vId vertexId) []vertexId {
+	return digraph.predecessorIds[digraph.predecessorOffsets[vId]:digraph.predecessorOffsets[vId+1]]
+}
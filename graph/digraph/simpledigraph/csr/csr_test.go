@@ -0,0 +1,157 @@
+package csr
+
+import (
+	"goraph/graph"
+	"slices"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var vertices = mapset.NewFromElements(1, 2, 3, 4)
+var edges = mapset.NewFromElements(
+	graph.NewEdge(1, 2),
+	graph.NewEdge(1, 4),
+	graph.NewEdge(2, 1),
+	graph.NewEdge(2, 3),
+	graph.NewEdge(2, 4),
+	graph.NewEdge(3, 4),
+)
+
+func TestCSRSimpleDigraph(t *testing.T) {
+	simpleDigraph, err := NewCSRSimpleDigraph(vertices, edges)
+	assert.NotNil(t, simpleDigraph)
+	assert.Nil(t, err)
+
+	digraphVertices := simpleDigraph.Vertices()
+	assert.Equal(t, vertices, digraphVertices)
+
+	digraphEdges := simpleDigraph.Edges()
+	assert.Equal(t, edges, digraphEdges)
+
+	// (2, 3) edge exists
+
+	twoThreeEdge := simpleDigraph.Edge(2, 3)
+	assert.NotNil(t, twoThreeEdge)
+	assert.Equal(t, graph.NewEdge(2, 3), *twoThreeEdge)
+
+	// (3, 2) edge does not exist
+
+	threeTwoEdge := simpleDigraph.Edge(3, 2)
+	assert.Nil(t, threeTwoEdge)
+
+	// (1, 1) loop edge does not exist
+
+	oneOneEdge := simpleDigraph.Edge(1, 1)
+	assert.Nil(t, oneOneEdge)
+}
+
+func TestCSRSimpleDigraph_Successors(t *testing.T) {
+	simpleDigraph, err := NewCSRSimpleDigraph(vertices, edges)
+	assert.NotNil(t, simpleDigraph)
+	assert.Nil(t, err)
+
+	assert.Equal(t, mapset.NewFromElements(1, 3, 4), simpleDigraph.Successors(2))
+	assert.Equal(t, mapset.New[int](), simpleDigraph.Successors(4))
+	assert.Equal(t, mapset.New[int](), simpleDigraph.Successors(-2))
+}
+
+func TestCSRSimpleDigraph_Predecessors(t *testing.T) {
+	simpleDigraph, err := NewCSRSimpleDigraph(vertices, edges)
+	assert.NotNil(t, simpleDigraph)
+	assert.Nil(t, err)
+
+	assert.Equal(t, mapset.NewFromElements(1), simpleDigraph.Predecessors(2))
+	assert.Equal(t, mapset.NewFromElements(1, 2, 3), simpleDigraph.Predecessors(4))
+	assert.Equal(t, mapset.New[int](), simpleDigraph.Predecessors(-6))
+}
+
+func TestNewCSRSimpleDigraphFromSeq(t *testing.T) {
+	// duplicate edges are added only once
+	streamedEdges := append(edges.Elements(), edges.Elements()...)
+
+	simpleDigraph, err := NewCSRSimpleDigraphFromSeq(vertices, slices.Values(streamedEdges))
+	assert.NotNil(t, simpleDigraph)
+	assert.Nil(t, err)
+
+	assert.Equal(t, edges, simpleDigraph.Edges())
+	assert.Equal(t, mapset.NewFromElements(1, 3, 4), simpleDigraph.Successors(2))
+	assert.Equal(t, mapset.NewFromElements(1, 2, 3), simpleDigraph.Predecessors(4))
+}
+
+func TestNewOrderedCSRSimpleDigraph(t *testing.T) {
+	simpleDigraph, err := NewOrderedCSRSimpleDigraph([]int{4, 2, 3, 1}, slices.Values(edges.Elements()))
+	assert.NotNil(t, simpleDigraph)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []int{4, 2, 3, 1}, slices.Collect(simpleDigraph.AllVertices()))
+	assert.Equal(
+		t,
+		[]graph.Edge[int]{
+			graph.NewEdge(2, 4),
+			graph.NewEdge(2, 3),
+			graph.NewEdge(2, 1),
+			graph.NewEdge(3, 4),
+			graph.NewEdge(1, 4),
+			graph.NewEdge(1, 2),
+		},
+		slices.Collect(simpleDigraph.AllEdges()),
+	)
+	assert.Equal(t, []int{2, 3, 1}, slices.Collect(simpleDigraph.PredecessorsSeq(4)))
+
+	simpleDigraph, err = NewOrderedCSRSimpleDigraph([]int{1, 2, 1}, slices.Values(edges.Elements()))
+	assert.Nil(t, simpleDigraph)
+	assert.NotNil(t, err)
+}
+
+func TestNewCSRSimpleDigraph_Errors(t *testing.T) {
+	simpleDigraph, err := NewCSRSimpleDigraph(
+		vertices,
+		mapset.NewFromElements(graph.NewEdge(1, 1)),
+	)
+	assert.Nil(t, simpleDigraph)
+	assert.NotNil(t, err)
+
+	simpleDigraph, err = NewCSRSimpleDigraph(
+		vertices,
+		mapset.NewFromElements(graph.NewEdge(1, 5)),
+	)
+	assert.Nil(t, simpleDigraph)
+	assert.NotNil(t, err)
+
+	simpleDigraph, err = NewCSRSimpleDigraph(nil, edges)
+	assert.Nil(t, simpleDigraph)
+	assert.NotNil(t, err)
+}
+
+func TestCSRSimpleDigraph_Seq(t *testing.T) {
+	simpleDigraph, err := NewCSRSimpleDigraph(vertices, edges)
+	assert.NotNil(t, simpleDigraph)
+	assert.Nil(t, err)
+
+	assert.Equal(t, vertices, mapset.NewFromElements(slices.Collect(simpleDigraph.AllVertices())...))
+	assert.Equal(t, edges, mapset.NewFromElements(slices.Collect(simpleDigraph.AllEdges())...))
+
+	assert.ElementsMatch(t, []int{1, 3, 4}, slices.Collect(simpleDigraph.SuccessorsSeq(2)))
+	assert.ElementsMatch(t, []int{1, 2, 3}, slices.Collect(simpleDigraph.PredecessorsSeq(4)))
+	assert.Empty(t, slices.Collect(simpleDigraph.SuccessorsSeq(-2)))
+	assert.Empty(t, slices.Collect(simpleDigraph.PredecessorsSeq(-2)))
+}
+
+func TestCSRSimpleDigraph_Counts(t *testing.T) {
+	simpleDigraph, err := NewCSRSimpleDigraph(vertices, edges)
+	assert.NotNil(t, simpleDigraph)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 4, simpleDigraph.Order())
+	assert.Equal(t, 6, simpleDigraph.Size())
+
+	assert.Equal(t, 3, simpleDigraph.OutDegree(2))
+	assert.Equal(t, 1, simpleDigraph.InDegree(2))
+	assert.Equal(t, 0, simpleDigraph.OutDegree(4))
+	assert.Equal(t, 3, simpleDigraph.InDegree(4))
+	assert.Equal(t, 0, simpleDigraph.OutDegree(-2))
+	assert.Equal(t, 0, simpleDigraph.InDegree(-2))
+}
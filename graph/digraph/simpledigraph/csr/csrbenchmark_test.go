@@ -0,0 +1,113 @@
+package csr
+
+import (
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"math/rand"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set"
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+type simpleDigraphConstructor func(
+	vertices set.Set[int],
+	edges set.Set[graph.Edge[int]],
+) (simpledigraph.SimpleDigraph[int], error)
+
+func BenchmarkCSRSimpleDigraph_New(b *testing.B) {
+	newSimpleDigraph_Benchmark(b, NewCSRSimpleDigraph[int], 10_000, 200_000)
+}
+
+func BenchmarkAdjacencyListSimpleDigraph_New(b *testing.B) {
+	newSimpleDigraph_Benchmark(b, al.NewAdjacencyListSimpleDigraph[int], 10_000, 200_000)
+}
+
+func BenchmarkCSRSimpleDigraph_Edge(b *testing.B) {
+	simpleDigraph_Edge_Benchmark(b, NewCSRSimpleDigraph[int], 10_000, 200_000)
+}
+
+func BenchmarkAdjacencyListSimpleDigraph_Edge(b *testing.B) {
+	simpleDigraph_Edge_Benchmark(b, al.NewAdjacencyListSimpleDigraph[int], 10_000, 200_000)
+}
+
+func newSimpleDigraph_Benchmark(
+	b *testing.B,
+	newSimpleDigraph simpleDigraphConstructor,
+	amountOfVertices int,
+	amountOfEdges int,
+) {
+	fmt.Printf(
+		"amountOfVertices = %d, amountOfEdges = %d\n",
+		amountOfVertices,
+		amountOfEdges,
+	)
+
+	vertices, edges := newVerticesAndEdges(amountOfVertices, amountOfEdges)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := newSimpleDigraph(vertices, edges); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func simpleDigraph_Edge_Benchmark(
+	b *testing.B,
+	newSimpleDigraph simpleDigraphConstructor,
+	amountOfVertices int,
+	amountOfEdges int,
+) {
+	fmt.Printf(
+		"amountOfVertices = %d, amountOfEdges = %d\n",
+		amountOfVertices,
+		amountOfEdges,
+	)
+
+	vertices, edges := newVerticesAndEdges(amountOfVertices, amountOfEdges)
+
+	simpleDigraph, err := newSimpleDigraph(vertices, edges)
+
+	if err != nil {
+		panic(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		simpleDigraph.Edge(rand.Intn(amountOfVertices)+1, rand.Intn(amountOfVertices)+1)
+	}
+}
+
+// Vertices are labeled from 1 to amountOfVertices.
+func newVerticesAndEdges(
+	amountOfVertices int,
+	amountOfEdges int,
+) (set.Set[int], set.Set[graph.Edge[int]]) {
+	vertices := mapset.New[int]()
+
+	for i := 1; i <= amountOfVertices; i++ {
+		vertices.Add(i)
+	}
+
+	edges := mapset.New[graph.Edge[int]]()
+
+	for i := 1; i <= amountOfEdges; i++ {
+		u := rand.Intn(amountOfVertices) + 1
+		v := rand.Intn(amountOfVertices) + 1
+
+		for u == v {
+			v = rand.Intn(amountOfVertices) + 1
+		}
+
+		edges.Add(graph.NewEdge(u, v))
+	}
+
+	return vertices, edges
+}
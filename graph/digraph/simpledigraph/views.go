@@ -0,0 +1,93 @@
+package simpledigraph
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set"
+)
+
+type reverseSimpleDigraph[V graph.Vertex] struct {
+	digraph.Digraph[V]
+
+	simpleDigraph SimpleDigraph[V]
+}
+
+type inducedSimpleSubgraph[V graph.Vertex] struct {
+	digraph.Digraph[V]
+
+	simpleDigraph SimpleDigraph[V]
+	vertices      set.Set[V]
+}
+
+type edgeFilteredSimpleDigraph[V graph.Vertex] struct {
+	digraph.Digraph[V]
+
+	simpleDigraph SimpleDigraph[V]
+	keep          func(edge graph.Edge[V]) bool
+}
+
+var _ SimpleDigraph[struct{}] = (*reverseSimpleDigraph[struct{}])(nil)
+var _ SimpleDigraph[struct{}] = (*inducedSimpleSubgraph[struct{}])(nil)
+var _ SimpleDigraph[struct{}] = (*edgeFilteredSimpleDigraph[struct{}])(nil)
+
+// Reverse is digraph.Reverse for SimpleDigraph.
+//
+// https://en.wikipedia.org/wiki/Transpose_graph
+func Reverse[V graph.Vertex](simpleDigraph SimpleDigraph[V]) SimpleDigraph[V] {
+	return &reverseSimpleDigraph[V]{digraph.Reverse[V](simpleDigraph), simpleDigraph}
+}
+
+// InducedSubgraph is digraph.InducedSubgraph for SimpleDigraph.
+//
+// https://en.wikipedia.org/wiki/Induced_subgraph
+func InducedSubgraph[V graph.Vertex](
+	simpleDigraph SimpleDigraph[V],
+	vertices set.Set[V],
+) SimpleDigraph[V] {
+	return &inducedSimpleSubgraph[V]{
+		digraph.InducedSubgraph[V](simpleDigraph, vertices),
+		simpleDigraph,
+		vertices,
+	}
+}
+
+// FilterEdges is digraph.FilterEdges for SimpleDigraph.
+func FilterEdges[V graph.Vertex](
+	simpleDigraph SimpleDigraph[V],
+	keep func(edge graph.Edge[V]) bool,
+) SimpleDigraph[V] {
+	return &edgeFilteredSimpleDigraph[V]{
+		digraph.FilterEdges[V](simpleDigraph, keep),
+		simpleDigraph,
+		keep,
+	}
+}
+
+func (view *reverseSimpleDigraph[V]) Edge(source, target V) *graph.Edge[V] {
+	if view.simpleDigraph.Edge(target, source) == nil {
+		return nil
+	}
+
+	edge := graph.NewEdge(source, target)
+
+	return &edge
+}
+
+func (view *inducedSimpleSubgraph[V]) Edge(source, target V) *graph.Edge[V] {
+	if !view.vertices.Contains(source) || !view.vertices.Contains(target) {
+		return nil
+	}
+
+	return view.simpleDigraph.Edge(source, target)
+}
+
+func (view *edgeFilteredSimpleDigraph[V]) Edge(source, target V) *graph.Edge[V] {
+	edge := view.simpleDigraph.Edge(source, target)
+
+	if edge == nil || !view.keep(*edge) {
+		return nil
+	}
+
+	return edge
+}
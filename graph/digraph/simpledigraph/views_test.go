@@ -0,0 +1,104 @@
+package simpledigraph_test
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var vertices = mapset.NewFromElements(1, 2, 3, 4)
+var edges = mapset.NewFromElements(
+	graph.NewEdge(1, 2),
+	graph.NewEdge(1, 4),
+	graph.NewEdge(2, 1),
+	graph.NewEdge(2, 3),
+	graph.NewEdge(2, 4),
+	graph.NewEdge(3, 4),
+)
+
+func TestReverse(t *testing.T) {
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	reverse := simpledigraph.Reverse(simpleDigraph)
+
+	assert.Equal(t, vertices, reverse.Vertices())
+	assert.Equal(
+		t,
+		mapset.NewFromElements(
+			graph.NewEdge(2, 1),
+			graph.NewEdge(4, 1),
+			graph.NewEdge(1, 2),
+			graph.NewEdge(3, 2),
+			graph.NewEdge(4, 2),
+			graph.NewEdge(4, 3),
+		),
+		reverse.Edges(),
+	)
+	assert.Equal(t, mapset.NewFromElements(1, 2, 3), reverse.Successors(4))
+	assert.Equal(t, mapset.NewFromElements(1, 3, 4), reverse.Predecessors(2))
+	assert.Equal(t, 3, reverse.OutDegree(4))
+	assert.Equal(t, 6, reverse.Size())
+
+	assert.NotNil(t, reverse.Edge(4, 3))
+	assert.Equal(t, graph.NewEdge(4, 3), *reverse.Edge(4, 3))
+	assert.Nil(t, reverse.Edge(3, 4))
+}
+
+func TestInducedSubgraph(t *testing.T) {
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	induced := simpledigraph.InducedSubgraph(simpleDigraph, mapset.NewFromElements(1, 2, 4, 5))
+
+	assert.Equal(t, mapset.NewFromElements(1, 2, 4), induced.Vertices())
+	assert.Equal(
+		t,
+		mapset.NewFromElements(
+			graph.NewEdge(1, 2),
+			graph.NewEdge(1, 4),
+			graph.NewEdge(2, 1),
+			graph.NewEdge(2, 4),
+		),
+		induced.Edges(),
+	)
+	assert.Equal(t, mapset.NewFromElements(1, 4), induced.Successors(2))
+	assert.Equal(t, mapset.New[int](), induced.Successors(3))
+	assert.Equal(t, 2, induced.InDegree(4))
+	assert.Equal(t, 3, induced.Order())
+	assert.Equal(t, 4, induced.Size())
+
+	assert.NotNil(t, induced.Edge(2, 4))
+	assert.Nil(t, induced.Edge(2, 3))
+}
+
+func TestFilterEdges(t *testing.T) {
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	filtered := simpledigraph.FilterEdges(simpleDigraph, func(edge graph.Edge[int]) bool {
+		return edge.Source() < edge.Target()
+	})
+
+	assert.Equal(t, vertices, filtered.Vertices())
+	assert.Equal(
+		t,
+		mapset.NewFromElements(
+			graph.NewEdge(1, 2),
+			graph.NewEdge(1, 4),
+			graph.NewEdge(2, 3),
+			graph.NewEdge(2, 4),
+			graph.NewEdge(3, 4),
+		),
+		filtered.Edges(),
+	)
+	assert.Equal(t, mapset.NewFromElements(3, 4), filtered.Successors(2))
+	assert.Equal(t, mapset.New[int](), filtered.Predecessors(1))
+	assert.Equal(t, 0, filtered.InDegree(1))
+	assert.Equal(t, 5, filtered.Size())
+
+	assert.NotNil(t, filtered.Edge(1, 2))
+	assert.Nil(t, filtered.Edge(2, 1))
+}
@@ -0,0 +1,276 @@
+package digraph
+
+import (
+	"goraph/graph"
+	"iter"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set"
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+type reverseDigraph[V graph.Vertex] struct {
+	digraph Digraph[V]
+}
+
+type inducedSubgraph[V graph.Vertex] struct {
+	digraph  Digraph[V]
+	vertices set.Set[V]
+}
+
+type edgeFilteredDigraph[V graph.Vertex] struct {
+	digraph Digraph[V]
+	keep    func(edge graph.Edge[V]) bool
+}
+
+var _ Digraph[struct{}] = (*reverseDigraph[struct{}])(nil)
+var _ Digraph[struct{}] = (*inducedSubgraph[struct{}])(nil)
+var _ Digraph[struct{}] = (*edgeFilteredDigraph[struct{}])(nil)
+
+// Reverse creates a lazy view of digraph in which every edge (u, v)
+// is replaced by edge (v, u).
+//
+// The view doesn't copy digraph, so it is created in O(1).
+//
+// https://en.wikipedia.org/wiki/Transpose_graph
+func Reverse[V graph.Vertex](digraph Digraph[V]) Digraph[V] {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+
+	return &reverseDigraph[V]{digraph}
+}
+
+// InducedSubgraph creates a lazy view of digraph that contains only vertices
+// from vertices set.Set that are present in digraph and only edges
+// between them.
+//
+// The view doesn't copy digraph, so it is created in O(1), but Order, Size
+// and degrees are computed on every call. vertices set.Set must not be modified
+// while the view is used.
+//
+// https://en.wikipedia.org/wiki/Induced_subgraph
+func InducedSubgraph[V graph.Vertex](digraph Digraph[V], vertices set.Set[V]) Digraph[V] {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+	if vertices == nil {
+		panic("vertices == nil")
+	}
+
+	return &inducedSubgraph[V]{digraph, vertices}
+}
+
+// FilterEdges creates a lazy view of digraph that contains all its vertices,
+// but only edges for which keep returns true.
+//
+// The view doesn't copy digraph, so it is created in O(1), but Size
+// and degrees are computed on every call. keep must be a pure function.
+func FilterEdges[V graph.Vertex](
+	digraph Digraph[V],
+	keep func(edge graph.Edge[V]) bool,
+) Digraph[V] {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+	if keep == nil {
+		panic("keep == nil")
+	}
+
+	return &edgeFilteredDigraph[V]{digraph, keep}
+}
+
+func (view *reverseDigraph[V]) Vertices() set.Set[V] {
+	return view.digraph.Vertices()
+}
+
+func (view *reverseDigraph[V]) Edges() set.Set[graph.Edge[V]] {
+	return collect(view.AllEdges())
+}
+
+func (view *reverseDigraph[V]) Successors(vertex V) set.Set[V] {
+	return view.digraph.Predecessors(vertex)
+}
+
+func (view *reverseDigraph[V]) Predecessors(vertex V) set.Set[V] {
+	return view.digraph.Successors(vertex)
+}
+
+func (view *reverseDigraph[V]) AllVertices() iter.Seq[V] {
+	return view.digraph.AllVertices()
+}
+
+func (view *reverseDigraph[V]) AllEdges() iter.Seq[graph.Edge[V]] {
+	return func(yield func(graph.Edge[V]) bool) {
+		for edge := range view.digraph.AllEdges() {
+			if !yield(graph.NewEdge(edge.Target(), edge.Source())) {
+				return
+			}
+		}
+	}
+}
+
+func (view *reverseDigraph[V]) SuccessorsSeq(vertex V) iter.Seq[V] {
+	return view.digraph.PredecessorsSeq(vertex)
+}
+
+func (view *reverseDigraph[V]) PredecessorsSeq(vertex V) iter.Seq[V] {
+	return view.digraph.SuccessorsSeq(vertex)
+}
+
+func (view *reverseDigraph[V]) OutDegree(vertex V) int {
+	return view.digraph.InDegree(vertex)
+}
+
+func (view *reverseDigraph[V]) InDegree(vertex V) int {
+	return view.digraph.OutDegree(vertex)
+}
+
+func (view *reverseDigraph[V]) Order() int {
+	return view.digraph.Order()
+}
+
+func (view *reverseDigraph[V]) Size() int {
+	return view.digraph.Size()
+}
+
+func (view *inducedSubgraph[V]) Vertices() set.Set[V] {
+	return collect(view.AllVertices())
+}
+
+func (view *inducedSubgraph[V]) Edges() set.Set[graph.Edge[V]] {
+	return collect(view.AllEdges())
+}
+
+func (view *inducedSubgraph[V]) Successors(vertex V) set.Set[V] {
+	return collect(view.SuccessorsSeq(vertex))
+}
+
+func (view *inducedSubgraph[V]) Predecessors(vertex V) set.Set[V] {
+	return collect(view.PredecessorsSeq(vertex))
+}
+
+func (view *inducedSubgraph[V]) AllVertices() iter.Seq[V] {
+	return filter(view.digraph.AllVertices(), view.vertices.Contains)
+}
+
+func (view *inducedSubgraph[V]) AllEdges() iter.Seq[graph.Edge[V]] {
+	return filter(view.digraph.AllEdges(), func(edge graph.Edge[V]) bool {
+		return view.vertices.Contains(edge.Source()) && view.vertices.Contains(edge.Target())
+	})
+}
+
+func (view *inducedSubgraph[V]) SuccessorsSeq(vertex V) iter.Seq[V] {
+	if !view.vertices.Contains(vertex) {
+		return empty[V]
+	}
+
+	return filter(view.digraph.SuccessorsSeq(vertex), view.vertices.Contains)
+}
+
+func (view *inducedSubgraph[V]) PredecessorsSeq(vertex V) iter.Seq[V] {
+	if !view.vertices.Contains(vertex) {
+		return empty[V]
+	}
+
+	return filter(view.digraph.PredecessorsSeq(vertex), view.vertices.Contains)
+}
+
+func (view *inducedSubgraph[V]) OutDegree(vertex V) int {
+	return count(view.SuccessorsSeq(vertex))
+}
+
+func (view *inducedSubgraph[V]) InDegree(vertex V) int {
+	return count(view.PredecessorsSeq(vertex))
+}
+
+func (view *inducedSubgraph[V]) Order() int {
+	return count(view.AllVertices())
+}
+
+func (view *inducedSubgraph[V]) Size() int {
+	return count(view.AllEdges())
+}
+
+func (view *edgeFilteredDigraph[V]) Vertices() set.Set[V] {
+	return view.digraph.Vertices()
+}
+
+func (view *edgeFilteredDigraph[V]) Edges() set.Set[graph.Edge[V]] {
+	return collect(view.AllEdges())
+}
+
+func (view *edgeFilteredDigraph[V]) Successors(vertex V) set.Set[V] {
+	return collect(view.SuccessorsSeq(vertex))
+}
+
+func (view *edgeFilteredDigraph[V]) Predecessors(vertex V) set.Set[V] {
+	return collect(view.PredecessorsSeq(vertex))
+}
+
+func (view *edgeFilteredDigraph[V]) AllVertices() iter.Seq[V] {
+	return view.digraph.AllVertices()
+}
+
+func (view *edgeFilteredDigraph[V]) AllEdges() iter.Seq[graph.Edge[V]] {
+	return filter(view.digraph.AllEdges(), view.keep)
+}
+
+func (view *edgeFilteredDigraph[V]) SuccessorsSeq(vertex V) iter.Seq[V] {
+	return filter(view.digraph.SuccessorsSeq(vertex), func(successor V) bool {
+		return view.keep(graph.NewEdge(vertex, successor))
+	})
+}
+
+func (view *edgeFilteredDigraph[V]) PredecessorsSeq(vertex V) iter.Seq[V] {
+	return filter(view.digraph.PredecessorsSeq(vertex), func(predecessor V) bool {
+		return view.keep(graph.NewEdge(predecessor, vertex))
+	})
+}
+
+func (view *edgeFilteredDigraph[V]) OutDegree(vertex V) int {
+	return count(view.SuccessorsSeq(vertex))
+}
+
+func (view *edgeFilteredDigraph[V]) InDegree(vertex V) int {
+	return count(view.PredecessorsSeq(vertex))
+}
+
+func (view *edgeFilteredDigraph[V]) Order() int {
+	return view.digraph.Order()
+}
+
+func (view *edgeFilteredDigraph[V]) Size() int {
+	return count(view.AllEdges())
+}
+
+func empty[T any](func(T) bool) {}
+
+func filter[T any](seq iter.Seq[T], keep func(element T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for element := range seq {
+			if keep(element) && !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+func count[T any](seq iter.Seq[T]) int {
+	amount := 0
+
+	for range seq {
+		amount++
+	}
+
+	return amount
+}
+
+func collect[T comparable](seq iter.Seq[T]) set.Set[T] {
+	elements := mapset.New[T]()
+
+	for element := range seq {
+		elements.Add(element)
+	}
+
+	return elements
+}
@@ -1,5 +1,7 @@
 package graph
 
+import "encoding/json"
+
 // Edge struct represents an immutable (so it is thread-safe) edge in Digraph.
 type Edge[V Vertex] struct {
 	source V
@@ -20,3 +22,31 @@ func (edge *Edge[V]) Source() V {
 func (edge *Edge[V]) Target() V {
 	return edge.target
 }
+
+// edgeJSON is a JSON representation of Edge.
+type edgeJSON[V Vertex] struct {
+	Source V `json:"source"`
+	Target V `json:"target"`
+}
+
+// MarshalJSON encodes this Edge as {"source": ..., "target": ...}.
+func (edge Edge[V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(edgeJSON[V]{edge.source, edge.target})
+}
+
+// UnmarshalJSON decodes Edge encoded by MarshalJSON.
+//
+// It is the only method that mutates Edge, so it must not be called
+// on Edge shared between goroutines.
+func (edge *Edge[V]) UnmarshalJSON(data []byte) error {
+	var decoded edgeJSON[V]
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	edge.source = decoded.Source
+	edge.target = decoded.Target
+
+	return nil
+}
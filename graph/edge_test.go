@@ -0,0 +1,22 @@
+package graph
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEdge_JSON(t *testing.T) {
+	edge := NewEdge("A", "B")
+
+	data, err := json.Marshal(edge)
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"source": "A", "target": "B"}`, string(data))
+
+	var unmarshaledEdge Edge[string]
+
+	assert.Nil(t, json.Unmarshal(data, &unmarshaledEdge))
+	assert.Equal(t, edge, unmarshaledEdge)
+}
@@ -0,0 +1,148 @@
+package dot
+
+import (
+	"bytes"
+	"goraph/graph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	mf "goraph/maxflow"
+	"strings"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func identity(vertex string) string {
+	return vertex
+}
+
+func TestWrite(t *testing.T) {
+	ab, bc := graph.NewEdge("A", "B"), graph.NewEdge("B", "C")
+
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements("A", "B", "C"),
+		mapset.NewFromElements(ab, bc),
+	)
+
+	var buffer bytes.Buffer
+
+	err := Write[string](&buffer, simpleDigraph, Options[string]{
+		VertexId:    identity,
+		VertexLabel: strings.ToLower,
+		Capacity:    mf.Capacity[string]{ab: 5, bc: 3},
+		Flow:        mf.Flow[string]{ab: 3, bc: 3},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(
+		t,
+		`digraph {
+	"A" [label="a"];
+	"B" [label="b"];
+	"C" [label="c"];
+	"A" -> "B" [label="3/5"];
+	"B" -> "C" [label="3/3", style=bold];
+}
+`,
+		buffer.String(),
+	)
+}
+
+func TestParse(t *testing.T) {
+	input := `
+/* hand-drawn network */
+strict digraph network {
+	graph [rankdir=LR]
+	node [shape=circle]
+	s [label="source"];
+	s -> a -> t [capacity=3]
+	s -> "b \"quoted\"" [capacity=2, color=red]
+	subgraph cluster {
+		edge [style=dashed]
+		"b \"quoted\"" -> t:n [capacity=4]
+	}
+	// comment
+	t
+	label = "example"
+}
+`
+
+	simpleDigraph, attributes, err := Parse(strings.NewReader(input))
+
+	assert.Nil(t, err)
+	assert.NotNil(t, simpleDigraph)
+
+	b := `b "quoted"`
+
+	assert.Equal(t, mapset.NewFromElements("s", "a", b, "t"), simpleDigraph.Vertices())
+	assert.Equal(
+		t,
+		mapset.NewFromElements(
+			graph.NewEdge("s", "a"),
+			graph.NewEdge("a", "t"),
+			graph.NewEdge("s", b),
+			graph.NewEdge(b, "t"),
+		),
+		simpleDigraph.Edges(),
+	)
+
+	assert.Equal(t, map[string]string{"rankdir": "LR", "label": "example"}, attributes.Graph)
+	assert.Equal(t, map[string]string{"shape": "circle", "label": "source"}, attributes.Vertices["s"])
+	assert.Equal(t, map[string]string{"shape": "circle"}, attributes.Vertices["t"])
+	assert.Equal(t, map[string]string{"capacity": "3"}, attributes.Edges[graph.NewEdge("a", "t")])
+	assert.Equal(
+		t,
+		map[string]string{"capacity": "2", "color": "red"},
+		attributes.Edges[graph.NewEdge("s", b)],
+	)
+	assert.Equal(
+		t,
+		map[string]string{"capacity": "4", "style": "dashed"},
+		attributes.Edges[graph.NewEdge(b, "t")],
+	)
+}
+
+func TestParse_Numerals(t *testing.T) {
+	simpleDigraph, _, err := Parse(strings.NewReader("digraph { -1 -> .5 -> 2. -> -0.25 }"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, mapset.NewFromElements("-1", ".5", "2.", "-0.25"), simpleDigraph.Vertices())
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements("A", `B\"`, "C"),
+		mapset.NewFromElements(graph.NewEdge("A", `B\"`), graph.NewEdge(`B\"`, "C")),
+	)
+
+	var buffer bytes.Buffer
+
+	assert.Nil(t, Write[string](&buffer, simpleDigraph, Options[string]{VertexId: identity}))
+
+	parsedSimpleDigraph, _, err := Parse(&buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, simpleDigraph.Vertices(), parsedSimpleDigraph.Vertices())
+	assert.Equal(t, simpleDigraph.Edges(), parsedSimpleDigraph.Edges())
+}
+
+func TestParse_Errors(t *testing.T) {
+	inputs := []string{
+		"graph { a -- b }",
+		"digraph { a -- b }",
+		"digraph { a -> a }",
+		"digraph { a -> b",
+		`digraph { "a }`,
+		"digraph { a -> - ; }",
+		"digraph { a -> . }",
+	}
+
+	for _, input := range inputs {
+		simpleDigraph, attributes, err := Parse(strings.NewReader(input))
+
+		assert.Nil(t, simpleDigraph)
+		assert.Nil(t, attributes)
+		assert.NotNil(t, err, input)
+	}
+}
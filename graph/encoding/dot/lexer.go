@@ -0,0 +1,305 @@
+package dot
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	eofToken tokenKind = iota
+	idToken
+	leftBraceToken
+	rightBraceToken
+	leftBracketToken
+	rightBracketToken
+	equalsToken
+	semicolonToken
+	commaToken
+	colonToken
+	directedEdgeToken
+	undirectedEdgeToken
+)
+
+var punctuation = map[rune]tokenKind{
+	'{': leftBraceToken,
+	'}': rightBraceToken,
+	'[': leftBracketToken,
+	']': rightBracketToken,
+	'=': equalsToken,
+	';': semicolonToken,
+	',': commaToken,
+	':': colonToken,
+}
+
+type token struct {
+	kind tokenKind
+
+	// text is an unescaped ID for idToken and the literal text otherwise.
+	text string
+
+	// quoted is true iff idToken is a double-quoted or HTML string,
+	// so it can't be a keyword.
+	quoted bool
+
+	line int
+}
+
+// keyword returns true iff token is an unquoted ID equal to keyword
+// (DOT keywords are case-insensitive).
+func (token token) keyword(keyword string) bool {
+	return token.kind == idToken && !token.quoted && strings.EqualFold(token.text, keyword)
+}
+
+type lexer struct {
+	input    []rune
+	position int
+	line     int
+}
+
+func newLexer(input string) *lexer {
+	return &lexer{input: []rune(input), line: 1}
+}
+
+func (lexer *lexer) next() (token, error) {
+	if err := lexer.skipWhitespaceAndComments(); err != nil {
+		return token{}, err
+	}
+
+	if lexer.position >= len(lexer.input) {
+		return token{kind: eofToken, line: lexer.line}, nil
+	}
+
+	line := lexer.line
+	r := lexer.input[lexer.position]
+
+	if kind, isPunctuation := punctuation[r]; isPunctuation {
+		lexer.position++
+
+		return token{kind: kind, text: string(r), line: line}, nil
+	}
+
+	switch {
+	case lexer.hasPrefix("->"):
+		lexer.position += 2
+
+		return token{kind: directedEdgeToken, text: "->", line: line}, nil
+
+	case lexer.hasPrefix("--"):
+		lexer.position += 2
+
+		return token{kind: undirectedEdgeToken, text: "--", line: line}, nil
+
+	case r == '"':
+		return lexer.quotedString()
+
+	case r == '<':
+		return lexer.htmlString()
+
+	case r == '-' || r == '.' || unicode.IsDigit(r):
+		return lexer.numeral()
+
+	case isIdRune(r):
+		start := lexer.position
+
+		for lexer.position < len(lexer.input) && (isIdRune(lexer.input[lexer.position]) ||
+			unicode.IsDigit(lexer.input[lexer.position])) {
+			lexer.position++
+		}
+
+		return token{kind: idToken, text: string(lexer.input[start:lexer.position]), line: line}, nil
+	}
+
+	return token{}, fmt.Errorf("line %d: unexpected character %q", line, r)
+}
+
+func (lexer *lexer) skipWhitespaceAndComments() error {
+	lineStart := lexer.position == 0
+
+	for lexer.position < len(lexer.input) {
+		r := lexer.input[lexer.position]
+
+		switch {
+		case r == '\n':
+			lexer.line++
+			lexer.position++
+			lineStart = true
+
+		case unicode.IsSpace(r):
+			lexer.position++
+
+		case r == '#' && lineStart, lexer.hasPrefix("//"):
+			for lexer.position < len(lexer.input) && lexer.input[lexer.position] != '\n' {
+				lexer.position++
+			}
+
+		case lexer.hasPrefix("/*"):
+			line := lexer.line
+			lexer.position += 2
+
+			for !lexer.hasPrefix("*/") {
+				if lexer.position >= len(lexer.input) {
+					return fmt.Errorf("line %d: unterminated comment", line)
+				}
+
+				if lexer.input[lexer.position] == '\n' {
+					lexer.line++
+				}
+
+				lexer.position++
+			}
+
+			lexer.position += 2
+
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// quotedString reads a double-quoted string, possibly concatenated
+// with other double-quoted strings by '+'.
+func (lexer *lexer) quotedString() (token, error) {
+	line := lexer.line
+
+	var builder strings.Builder
+
+	for {
+		// skip opening '"'
+		lexer.position++
+
+		for {
+			if lexer.position >= len(lexer.input) {
+				return token{}, fmt.Errorf("line %d: unterminated string", line)
+			}
+
+			r := lexer.input[lexer.position]
+			lexer.position++
+
+			if r == '"' {
+				break
+			}
+
+			if r == '\n' {
+				lexer.line++
+			}
+
+			if r == '\\' && lexer.position < len(lexer.input) {
+				escaped := lexer.input[lexer.position]
+
+				switch escaped {
+				case '"', '\\':
+					lexer.position++
+					builder.WriteRune(escaped)
+
+					continue
+
+				case '\n':
+					// line continuation
+					lexer.position++
+					lexer.line++
+
+					continue
+				}
+			}
+
+			builder.WriteRune(r)
+		}
+
+		// look for '+' concatenation
+		position, lineBeforeConcatenation := lexer.position, lexer.line
+
+		if err := lexer.skipWhitespaceAndComments(); err != nil {
+			return token{}, err
+		}
+
+		if !lexer.hasPrefix("+") {
+			lexer.position, lexer.line = position, lineBeforeConcatenation
+
+			break
+		}
+
+		lexer.position++
+
+		if err := lexer.skipWhitespaceAndComments(); err != nil {
+			return token{}, err
+		}
+
+		if !lexer.hasPrefix(`"`) {
+			return token{}, fmt.Errorf("line %d: expected string after '+'", lexer.line)
+		}
+	}
+
+	return token{kind: idToken, text: builder.String(), quoted: true, line: line}, nil
+}
+
+// htmlString reads an HTML string, i.e. text between matching '<' and '>'.
+func (lexer *lexer) htmlString() (token, error) {
+	line := lexer.line
+	depth := 0
+	start := lexer.position + 1
+
+	for lexer.position < len(lexer.input) {
+		switch lexer.input[lexer.position] {
+		case '<':
+			depth++
+		case '>':
+			depth--
+		case '\n':
+			lexer.line++
+		}
+
+		lexer.position++
+
+		if depth == 0 {
+			text := string(lexer.input[start : lexer.position-1])
+
+			return token{kind: idToken, text: text, quoted: true, line: line}, nil
+		}
+	}
+
+	return token{}, fmt.Errorf("line %d: unterminated HTML string", line)
+}
+
+func (lexer *lexer) numeral() (token, error) {
+	line := lexer.line
+	start := lexer.position
+
+	if lexer.input[lexer.position] == '-' {
+		lexer.position++
+	}
+
+	hasDigits := false
+
+	for lexer.position < len(lexer.input) &&
+		(unicode.IsDigit(lexer.input[lexer.position]) || lexer.input[lexer.position] == '.') {
+		hasDigits = hasDigits || unicode.IsDigit(lexer.input[lexer.position])
+		lexer.position++
+	}
+
+	text := string(lexer.input[start:lexer.position])
+
+	if !hasDigits {
+		return token{}, fmt.Errorf("line %d: numeral %q has no digits", line, text)
+	}
+
+	return token{kind: idToken, text: text, line: line}, nil
+}
+
+func (lexer *lexer) hasPrefix(prefix string) bool {
+	for i, r := range []rune(prefix) {
+		if lexer.position+i >= len(lexer.input) || lexer.input[lexer.position+i] != r {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isIdRune(r rune) bool {
+	return r == '_' || r >= 0x80 || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+}
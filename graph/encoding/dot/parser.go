@@ -0,0 +1,385 @@
+package dot
+
+import (
+	"errors"
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"io"
+	"maps"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// Attributes holds DOT attributes of a parsed digraph.
+type Attributes struct {
+	// Graph maps graph attribute name to its value.
+	Graph map[string]string
+
+	// Vertices has a mapping for every vertex of parsed digraph
+	// (possibly to an empty map).
+	Vertices map[string]map[string]string
+
+	// Edges has a mapping for every edge of parsed digraph
+	// (possibly to an empty map).
+	Edges map[graph.Edge[string]]map[string]string
+}
+
+type parser struct {
+	lexer   *lexer
+	current token
+
+	attributes *Attributes
+}
+
+// Parse parses a digraph in DOT language from r and creates an
+// immutable simpledigraph.SimpleDigraph using adjacency list ADT
+// with vertices labeled by their DOT IDs.
+//
+// Default node and edge attributes are applied to vertices and edges
+// declared after them. Subgraphs are flattened, ports are ignored.
+// Undirected graphs, loops and subgraphs as edge operands are not supported.
+//
+// https://graphviz.org/doc/info/lang.html
+func Parse(r io.Reader) (simpledigraph.SimpleDigraph[string], *Attributes, error) {
+	if r == nil {
+		return nil, nil, errors.New("r == nil")
+	}
+
+	input, err := io.ReadAll(r)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parser := &parser{
+		lexer: newLexer(string(input)),
+		attributes: &Attributes{
+			Graph:    make(map[string]string),
+			Vertices: make(map[string]map[string]string),
+			Edges:    make(map[graph.Edge[string]]map[string]string),
+		},
+	}
+
+	if err := parser.advance(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := parser.parseGraph(); err != nil {
+		return nil, nil, err
+	}
+
+	vertices := mapset.New[string]()
+	edges := mapset.New[graph.Edge[string]]()
+
+	for vertex := range parser.attributes.Vertices {
+		vertices.Add(vertex)
+	}
+
+	for edge := range parser.attributes.Edges {
+		edges.Add(edge)
+	}
+
+	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return simpleDigraph, parser.attributes, nil
+}
+
+func (parser *parser) parseGraph() error {
+	if parser.current.keyword("strict") {
+		if err := parser.advance(); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case parser.current.keyword("graph"):
+		return parser.errorf("undirected graphs are not supported")
+
+	case !parser.current.keyword("digraph"):
+		return parser.errorf("expected 'digraph', got %q", parser.current.text)
+	}
+
+	if err := parser.advance(); err != nil {
+		return err
+	}
+
+	// optional graph ID
+	if parser.current.kind == idToken {
+		if err := parser.advance(); err != nil {
+			return err
+		}
+	}
+
+	if err := parser.parseStatementList(map[string]string{}, map[string]string{}); err != nil {
+		return err
+	}
+
+	if parser.current.kind != eofToken {
+		return parser.errorf("unexpected %q after graph", parser.current.text)
+	}
+
+	return nil
+}
+
+// parseStatementList parses '{' stmt_list '}' with its own scope
+// of default node and edge attributes.
+func (parser *parser) parseStatementList(
+	nodeDefaults map[string]string,
+	edgeDefaults map[string]string,
+) error {
+	if err := parser.expect(leftBraceToken); err != nil {
+		return err
+	}
+
+	nodeDefaults = maps.Clone(nodeDefaults)
+	edgeDefaults = maps.Clone(edgeDefaults)
+
+	for parser.current.kind != rightBraceToken {
+		if parser.current.kind == eofToken {
+			return parser.errorf("unexpected end of input, expected '}'")
+		}
+
+		if err := parser.parseStatement(nodeDefaults, edgeDefaults); err != nil {
+			return err
+		}
+
+		if parser.current.kind == semicolonToken {
+			if err := parser.advance(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return parser.advance()
+}
+
+func (parser *parser) parseStatement(
+	nodeDefaults map[string]string,
+	edgeDefaults map[string]string,
+) error {
+	switch {
+	case parser.current.keyword("graph"):
+		return parser.parseDefaults(parser.attributes.Graph)
+
+	case parser.current.keyword("node"):
+		return parser.parseDefaults(nodeDefaults)
+
+	case parser.current.keyword("edge"):
+		return parser.parseDefaults(edgeDefaults)
+
+	case parser.current.keyword("subgraph"):
+		if err := parser.advance(); err != nil {
+			return err
+		}
+
+		// optional subgraph ID
+		if parser.current.kind == idToken {
+			if err := parser.advance(); err != nil {
+				return err
+			}
+		}
+
+		return parser.parseStatementList(nodeDefaults, edgeDefaults)
+
+	case parser.current.kind == leftBraceToken:
+		return parser.parseStatementList(nodeDefaults, edgeDefaults)
+
+	case parser.current.kind != idToken:
+		return parser.errorf("unexpected %q", parser.current.text)
+	}
+
+	id := parser.current.text
+
+	if err := parser.advance(); err != nil {
+		return err
+	}
+
+	// graph attribute statement ID '=' ID
+	if parser.current.kind == equalsToken {
+		if err := parser.advance(); err != nil {
+			return err
+		}
+
+		if parser.current.kind != idToken {
+			return parser.errorf("expected attribute value, got %q", parser.current.text)
+		}
+
+		parser.attributes.Graph[id] = parser.current.text
+
+		return parser.advance()
+	}
+
+	if err := parser.skipPort(); err != nil {
+		return err
+	}
+
+	// node or edge statement
+	vertices := []string{id}
+
+	for parser.current.kind == directedEdgeToken || parser.current.kind == undirectedEdgeToken {
+		if parser.current.kind == undirectedEdgeToken {
+			return parser.errorf("undirected edges are not supported")
+		}
+
+		if err := parser.advance(); err != nil {
+			return err
+		}
+
+		if parser.current.kind != idToken || parser.current.keyword("subgraph") {
+			return parser.errorf("expected vertex ID, got %q (subgraphs as edge operands are not supported)", parser.current.text)
+		}
+
+		vertices = append(vertices, parser.current.text)
+
+		if err := parser.advance(); err != nil {
+			return err
+		}
+
+		if err := parser.skipPort(); err != nil {
+			return err
+		}
+	}
+
+	attributes := make(map[string]string)
+
+	if err := parser.parseAttributeLists(attributes); err != nil {
+		return err
+	}
+
+	if len(vertices) == 1 {
+		parser.addVertex(id, nodeDefaults)
+		maps.Copy(parser.attributes.Vertices[id], attributes)
+
+		return nil
+	}
+
+	for i := 0; i+1 < len(vertices); i++ {
+		parser.addVertex(vertices[i], nodeDefaults)
+		parser.addVertex(vertices[i+1], nodeDefaults)
+
+		edge := graph.NewEdge(vertices[i], vertices[i+1])
+
+		if _, isPresent := parser.attributes.Edges[edge]; !isPresent {
+			parser.attributes.Edges[edge] = maps.Clone(edgeDefaults)
+		}
+
+		maps.Copy(parser.attributes.Edges[edge], attributes)
+	}
+
+	return nil
+}
+
+func (parser *parser) addVertex(vertex string, nodeDefaults map[string]string) {
+	if _, isPresent := parser.attributes.Vertices[vertex]; !isPresent {
+		parser.attributes.Vertices[vertex] = maps.Clone(nodeDefaults)
+	}
+}
+
+// parseDefaults parses 'graph' | 'node' | 'edge' attr_list.
+func (parser *parser) parseDefaults(defaults map[string]string) error {
+	if err := parser.advance(); err != nil {
+		return err
+	}
+
+	if parser.current.kind != leftBracketToken {
+		return parser.errorf("expected '[', got %q", parser.current.text)
+	}
+
+	return parser.parseAttributeLists(defaults)
+}
+
+// parseAttributeLists parses optional ('[' a_list ']')+ into attributes.
+func (parser *parser) parseAttributeLists(attributes map[string]string) error {
+	for parser.current.kind == leftBracketToken {
+		if err := parser.advance(); err != nil {
+			return err
+		}
+
+		for parser.current.kind != rightBracketToken {
+			if parser.current.kind != idToken {
+				return parser.errorf("expected attribute name, got %q", parser.current.text)
+			}
+
+			name := parser.current.text
+
+			if err := parser.advance(); err != nil {
+				return err
+			}
+
+			if err := parser.expect(equalsToken); err != nil {
+				return err
+			}
+
+			if parser.current.kind != idToken {
+				return parser.errorf("expected attribute value, got %q", parser.current.text)
+			}
+
+			attributes[name] = parser.current.text
+
+			if err := parser.advance(); err != nil {
+				return err
+			}
+
+			if parser.current.kind == semicolonToken || parser.current.kind == commaToken {
+				if err := parser.advance(); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := parser.advance(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// skipPort skips optional port (':' ID [':' ID]) of a vertex.
+func (parser *parser) skipPort() error {
+	for i := 0; i < 2 && parser.current.kind == colonToken; i++ {
+		if err := parser.advance(); err != nil {
+			return err
+		}
+
+		if parser.current.kind != idToken {
+			return parser.errorf("expected port, got %q", parser.current.text)
+		}
+
+		if err := parser.advance(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (parser *parser) expect(kind tokenKind) error {
+	if parser.current.kind != kind {
+		return parser.errorf("unexpected %q", parser.current.text)
+	}
+
+	return parser.advance()
+}
+
+func (parser *parser) advance() error {
+	token, err := parser.lexer.next()
+
+	if err != nil {
+		return err
+	}
+
+	parser.current = token
+
+	return nil
+}
+
+func (parser *parser) errorf(format string, arguments ...any) error {
+	return fmt.Errorf("line %d: %s", parser.current.line, fmt.Sprintf(format, arguments...))
+}
@@ -0,0 +1,132 @@
+package dot
+
+import (
+	"bufio"
+	"cmp"
+	"errors"
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph"
+	mf "goraph/maxflow"
+	"io"
+	"slices"
+	"strings"
+)
+
+// Options configures Write.
+type Options[V graph.Vertex] struct {
+	// VertexId must return a unique DOT ID for every vertex. It is required.
+	VertexId func(vertex V) string
+
+	// VertexLabel returns a label of vertex. If it is nil, then vertices
+	// are written without labels.
+	VertexLabel func(vertex V) string
+
+	// Capacity annotates edges with their capacities if it is not nil.
+	Capacity mf.Capacity[V]
+
+	// Flow annotates edges with their flows if it is not nil.
+	//
+	// If both Capacity and Flow are not nil, then every edge is labeled
+	// as "flow/capacity" and saturated edges are bold.
+	Flow mf.Flow[V]
+}
+
+// Write writes digraph in DOT language to w.
+//
+// Vertices and edges are sorted by their IDs, so the output is deterministic.
+//
+// https://graphviz.org/doc/info/lang.html
+func Write[V graph.Vertex](w io.Writer, digraph digraph.Digraph[V], options Options[V]) error {
+	if w == nil {
+		return errors.New("w == nil")
+	}
+	if digraph == nil {
+		return errors.New("digraph == nil")
+	}
+	if options.VertexId == nil {
+		return errors.New("options.VertexId == nil")
+	}
+
+	vertices := slices.Collect(digraph.AllVertices())
+	slices.SortFunc(vertices, func(u, v V) int {
+		return cmp.Compare(options.VertexId(u), options.VertexId(v))
+	})
+
+	edges := slices.Collect(digraph.AllEdges())
+	slices.SortFunc(edges, func(uv, xy graph.Edge[V]) int {
+		return cmp.Or(
+			cmp.Compare(options.VertexId(uv.Source()), options.VertexId(xy.Source())),
+			cmp.Compare(options.VertexId(uv.Target()), options.VertexId(xy.Target())),
+		)
+	})
+
+	writer := bufio.NewWriter(w)
+
+	fmt.Fprintln(writer, "digraph {")
+
+	for _, vertex := range vertices {
+		fmt.Fprintf(writer, "\t%s", quote(options.VertexId(vertex)))
+
+		if options.VertexLabel != nil {
+			fmt.Fprintf(writer, " [label=%s]", quote(options.VertexLabel(vertex)))
+		}
+
+		fmt.Fprintln(writer, ";")
+	}
+
+	for _, edge := range edges {
+		fmt.Fprintf(
+			writer,
+			"\t%s -> %s%s;\n",
+			quote(options.VertexId(edge.Source())),
+			quote(options.VertexId(edge.Target())),
+			edgeAttributes(edge, options),
+		)
+	}
+
+	fmt.Fprintln(writer, "}")
+
+	return writer.Flush()
+}
+
+func edgeAttributes[V graph.Vertex](edge graph.Edge[V], options Options[V]) string {
+	capacity, capacityIsPresent := options.Capacity[edge]
+	flow, flowIsPresent := options.Flow[edge]
+
+	switch {
+	case capacityIsPresent && flowIsPresent:
+		if flow == capacity {
+			return fmt.Sprintf(" [label=\"%d/%d\", style=bold]", flow, capacity)
+		}
+
+		return fmt.Sprintf(" [label=\"%d/%d\"]", flow, capacity)
+
+	case capacityIsPresent:
+		return fmt.Sprintf(" [label=\"%d\"]", capacity)
+
+	case flowIsPresent:
+		return fmt.Sprintf(" [label=\"%d\"]", flow)
+	}
+
+	return ""
+}
+
+// quote returns id as DOT double-quoted string.
+func quote(id string) string {
+	var builder strings.Builder
+
+	builder.WriteByte('"')
+
+	for _, r := range id {
+		if r == '"' || r == '\\' {
+			builder.WriteByte('\\')
+		}
+
+		builder.WriteRune(r)
+	}
+
+	builder.WriteByte('"')
+
+	return builder.String()
+}
@@ -0,0 +1,152 @@
+package edgelist
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"io"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// Read reads a whitespace-separated edge list from r and creates an immutable
+// simpledigraph.SimpleDigraph using adjacency list ADT.
+//
+// Every line is either "source target" (an edge), "vertex" (an isolated vertex),
+// empty or a comment starting with '#'. parse converts a field to a vertex.
+func Read[V graph.Vertex](
+	r io.Reader,
+	parse func(field string) (V, error),
+) (simpledigraph.SimpleDigraph[V], error) {
+	if r == nil {
+		return nil, errors.New("r == nil")
+	}
+	if parse == nil {
+		return nil, errors.New("parse == nil")
+	}
+
+	vertices := mapset.New[V]()
+	edges := mapset.New[graph.Edge[V]]()
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		if len(fields) > 2 {
+			return nil, fmt.Errorf("line %d: expected at most 2 fields, got %d", lineNumber, len(fields))
+		}
+
+		lineVertices := make([]V, len(fields))
+
+		for i, field := range fields {
+			vertex, err := parse(field)
+
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+
+			vertices.Add(vertex)
+			lineVertices[i] = vertex
+		}
+
+		if len(lineVertices) == 2 {
+			edges.Add(graph.NewEdge(lineVertices[0], lineVertices[1]))
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return al.NewAdjacencyListSimpleDigraph(vertices, edges)
+}
+
+// Write writes digraph as a whitespace-separated edge list to w, one edge
+// per line, followed by isolated vertices, one per line.
+//
+// format converts a vertex to a field, so it must return a non-empty string
+// without whitespace. Lines are sorted, so the output is deterministic.
+func Write[V graph.Vertex](
+	w io.Writer,
+	digraph digraph.Digraph[V],
+	format func(vertex V) string,
+) error {
+	if w == nil {
+		return errors.New("w == nil")
+	}
+	if digraph == nil {
+		return errors.New("digraph == nil")
+	}
+	if format == nil {
+		return errors.New("format == nil")
+	}
+
+	lines := make([]string, 0, digraph.Size())
+	isolatedVertexLines := make([]string, 0)
+
+	for edge := range digraph.AllEdges() {
+		source, err := formatField(edge.Source(), format)
+
+		if err != nil {
+			return err
+		}
+
+		target, err := formatField(edge.Target(), format)
+
+		if err != nil {
+			return err
+		}
+
+		lines = append(lines, source+" "+target)
+	}
+
+	for vertex := range digraph.AllVertices() {
+		if digraph.OutDegree(vertex) > 0 || digraph.InDegree(vertex) > 0 {
+			continue
+		}
+
+		field, err := formatField(vertex, format)
+
+		if err != nil {
+			return err
+		}
+
+		isolatedVertexLines = append(isolatedVertexLines, field)
+	}
+
+	slices.Sort(lines)
+	slices.Sort(isolatedVertexLines)
+
+	writer := bufio.NewWriter(w)
+
+	for _, line := range slices.Concat(lines, isolatedVertexLines) {
+		fmt.Fprintln(writer, line)
+	}
+
+	return writer.Flush()
+}
+
+func formatField[V graph.Vertex](vertex V, format func(vertex V) string) (string, error) {
+	field := format(vertex)
+
+	if field == "" || strings.ContainsFunc(field, unicode.IsSpace) || strings.HasPrefix(field, "#") {
+		return "", fmt.Errorf("vertex %+v is formatted as invalid field %q", vertex, field)
+	}
+
+	return field, nil
+}
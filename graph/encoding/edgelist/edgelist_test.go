@@ -0,0 +1,76 @@
+package edgelist
+
+import (
+	"bytes"
+	"goraph/graph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	input := `# comment
+1 2
+2	3
+
+3 1
+4
+`
+
+	simpleDigraph, err := Read(strings.NewReader(input), strconv.Atoi)
+
+	assert.Nil(t, err)
+	assert.Equal(t, mapset.NewFromElements(1, 2, 3, 4), simpleDigraph.Vertices())
+	assert.Equal(
+		t,
+		mapset.NewFromElements(graph.NewEdge(1, 2), graph.NewEdge(2, 3), graph.NewEdge(3, 1)),
+		simpleDigraph.Edges(),
+	)
+}
+
+func TestRead_Errors(t *testing.T) {
+	inputs := []string{"1 2 3\n", "1 x\n", "1 1\n"}
+
+	for _, input := range inputs {
+		simpleDigraph, err := Read(strings.NewReader(input), strconv.Atoi)
+
+		assert.Nil(t, simpleDigraph)
+		assert.NotNil(t, err, input)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4),
+		mapset.NewFromElements(graph.NewEdge(2, 3), graph.NewEdge(1, 2)),
+	)
+
+	var buffer bytes.Buffer
+
+	assert.Nil(t, Write[int](&buffer, simpleDigraph, strconv.Itoa))
+	assert.Equal(t, "1 2\n2 3\n4\n", buffer.String())
+
+	readSimpleDigraph, err := Read(&buffer, strconv.Atoi)
+
+	assert.Nil(t, err)
+	assert.Equal(t, simpleDigraph.Vertices(), readSimpleDigraph.Vertices())
+	assert.Equal(t, simpleDigraph.Edges(), readSimpleDigraph.Edges())
+}
+
+func TestWrite_InvalidField(t *testing.T) {
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements("a b"),
+		mapset.New[graph.Edge[string]](),
+	)
+
+	var buffer bytes.Buffer
+
+	err := Write[string](&buffer, simpleDigraph, func(vertex string) string { return vertex })
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,485 @@
+package gexf
+
+import (
+	"cmp"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/attribute"
+	"goraph/graph/digraph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+const (
+	namespace = "http://gexf.net/1.3"
+	version   = "1.3"
+
+	// LabelAttribute is a name of attribute.String attribute that holds
+	// GEXF node or edge label.
+	LabelAttribute = "label"
+
+	// WeightAttribute is a name of attribute.Double attribute that holds
+	// GEXF edge weight.
+	WeightAttribute = "weight"
+)
+
+type document struct {
+	XMLName    xml.Name     `xml:"gexf"`
+	Attributes []xml.Attr   `xml:",any,attr"`
+	Graph      graphElement `xml:"graph"`
+}
+
+type graphElement struct {
+	DefaultEdgeType string              `xml:"defaultedgetype,attr,omitempty"`
+	AttributeLists  []attributesElement `xml:"attributes"`
+	Nodes           []nodeElement       `xml:"nodes>node"`
+	Edges           []edgeElement       `xml:"edges>edge"`
+}
+
+type attributesElement struct {
+	Class      string             `xml:"class,attr"`
+	Attributes []attributeElement `xml:"attribute"`
+}
+
+type attributeElement struct {
+	Id      string  `xml:"id,attr"`
+	Title   string  `xml:"title,attr"`
+	Type    string  `xml:"type,attr"`
+	Default *string `xml:"default"`
+}
+
+type nodeElement struct {
+	Id        string            `xml:"id,attr"`
+	Label     *string           `xml:"label,attr"`
+	AttValues []attValueElement `xml:"attvalues>attvalue"`
+}
+
+type edgeElement struct {
+	Id        string            `xml:"id,attr"`
+	Source    string            `xml:"source,attr"`
+	Target    string            `xml:"target,attr"`
+	Type      string            `xml:"type,attr,omitempty"`
+	Label     *string           `xml:"label,attr"`
+	Weight    *string           `xml:"weight,attr"`
+	AttValues []attValueElement `xml:"attvalues>attvalue"`
+}
+
+type attValueElement struct {
+	For   string `xml:"for,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// attributeDeclaration is a resolved GEXF attribute.
+type attributeDeclaration struct {
+	title        string
+	declaration  attribute.Declaration
+	defaultValue *attribute.Value
+}
+
+var typeNames = map[attribute.Type]string{
+	attribute.Bool:   "boolean",
+	attribute.Int:    "integer",
+	attribute.Long:   "long",
+	attribute.Float:  "float",
+	attribute.Double: "double",
+	attribute.String: "string",
+}
+
+// Read reads a GEXF document from r and creates an immutable
+// simpledigraph.SimpleDigraph using adjacency list ADT with vertices labeled
+// by node IDs and attribute.Store with their attributes.
+//
+// Node and edge labels are stored as LabelAttribute and edge weights
+// as WeightAttribute. Attributes of unknown types (e.g. liststring) and
+// values of undeclared attributes are kept as attribute.Unknown values.
+// Visualization and dynamics elements are ignored.
+//
+// goraph has no undirected graph type, so undirected and mutual edges are rejected.
+//
+// https://gexf.net/schema.html
+func Read(r io.Reader) (simpledigraph.SimpleDigraph[string], *attribute.Store[string], error) {
+	if r == nil {
+		return nil, nil, errors.New("r == nil")
+	}
+
+	var document document
+
+	if err := xml.NewDecoder(r).Decode(&document); err != nil {
+		return nil, nil, err
+	}
+
+	store := attribute.NewStore[string]()
+
+	for _, xmlAttribute := range document.Attributes {
+		if xmlAttribute.Name.Space == "xmlns" {
+			store.XMLNamespaces[xmlAttribute.Name.Local] = xmlAttribute.Value
+		}
+	}
+
+	declarations, err := resolveAttributes(document.Graph.AttributeLists)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vertices := mapset.New[string]()
+	edges := mapset.New[graph.Edge[string]]()
+
+	for _, node := range document.Graph.Nodes {
+		if vertices.Contains(node.Id) {
+			return nil, nil, fmt.Errorf("duplicate node %q", node.Id)
+		}
+
+		vertices.Add(node.Id)
+		store.Vertices[node.Id] = make(map[string]attribute.Value)
+
+		if node.Label != nil {
+			store.Vertices[node.Id][LabelAttribute] = attribute.NewString(*node.Label)
+		}
+
+		if err := readAttValues(node.AttValues, declarations["node"], store.Vertices[node.Id]); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, edgeXML := range document.Graph.Edges {
+		edgeType := cmp.Or(edgeXML.Type, document.Graph.DefaultEdgeType, "undirected")
+
+		if edgeType != "directed" {
+			return nil, nil, fmt.Errorf(
+				"%s edge (%s, %s) is not supported",
+				edgeType,
+				edgeXML.Source,
+				edgeXML.Target,
+			)
+		}
+
+		edge := graph.NewEdge(edgeXML.Source, edgeXML.Target)
+
+		if edges.Contains(edge) {
+			return nil, nil, fmt.Errorf("parallel edge %+v is not supported", edge)
+		}
+
+		edges.Add(edge)
+		store.Edges[edge] = make(map[string]attribute.Value)
+
+		if edgeXML.Label != nil {
+			store.Edges[edge][LabelAttribute] = attribute.NewString(*edgeXML.Label)
+		}
+
+		if edgeXML.Weight != nil {
+			weight, err := strconv.ParseFloat(strings.TrimSpace(*edgeXML.Weight), 64)
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("weight of edge %+v: %w", edge, err)
+			}
+
+			store.Edges[edge][WeightAttribute] = attribute.NewDouble(weight)
+		}
+
+		if err := readAttValues(edgeXML.AttValues, declarations["edge"], store.Edges[edge]); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return simpleDigraph, store, nil
+}
+
+func resolveAttributes(
+	attributeLists []attributesElement,
+) (map[string]map[string]attributeDeclaration, error) {
+	// class -> attribute id -> attributeDeclaration
+	declarations := map[string]map[string]attributeDeclaration{
+		"node": make(map[string]attributeDeclaration),
+		"edge": make(map[string]attributeDeclaration),
+	}
+
+	for _, attributeList := range attributeLists {
+		if _, isPresent := declarations[attributeList.Class]; !isPresent {
+			return nil, fmt.Errorf("unknown attributes class %q", attributeList.Class)
+		}
+
+		for _, attributeXML := range attributeList.Attributes {
+			declaration := attributeDeclaration{
+				title: cmp.Or(attributeXML.Title, attributeXML.Id),
+				declaration: attribute.Declaration{
+					Type:        attribute.Unknown,
+					UnknownType: attributeXML.Type,
+				},
+			}
+
+			for valueType, typeName := range typeNames {
+				if attributeXML.Type == typeName {
+					declaration.declaration = attribute.Declaration{Type: valueType}
+				}
+			}
+
+			if attributeXML.Default != nil {
+				defaultValue, err := parse(declaration.declaration, *attributeXML.Default)
+
+				if err != nil {
+					return nil, fmt.Errorf("default of attribute %q: %w", attributeXML.Id, err)
+				}
+
+				declaration.defaultValue = &defaultValue
+			}
+
+			declarations[attributeList.Class][attributeXML.Id] = declaration
+		}
+	}
+
+	return declarations, nil
+}
+
+func readAttValues(
+	attValues []attValueElement,
+	declarations map[string]attributeDeclaration,
+	attributes map[string]attribute.Value,
+) error {
+	for _, declaration := range declarations {
+		if declaration.defaultValue != nil {
+			attributes[declaration.title] = *declaration.defaultValue
+		}
+	}
+
+	for _, attValue := range attValues {
+		declaration, isDeclared := declarations[attValue.For]
+
+		if !isDeclared {
+			attributes[attValue.For] = attribute.NewUnknown("", attValue.Value)
+
+			continue
+		}
+
+		value, err := parse(declaration.declaration, attValue.Value)
+
+		if err != nil {
+			return fmt.Errorf("value of attribute %q: %w", attValue.For, err)
+		}
+
+		attributes[declaration.title] = value
+	}
+
+	return nil
+}
+
+func parse(declaration attribute.Declaration, text string) (attribute.Value, error) {
+	switch declaration.Type {
+	case attribute.Unknown:
+		return attribute.NewUnknown(declaration.UnknownType, text), nil
+
+	case attribute.String:
+		return attribute.NewString(text), nil
+	}
+
+	return attribute.Parse(declaration.Type, strings.TrimSpace(text))
+}
+
+// Write writes digraph with its attributes from store (which may be nil)
+// as a GEXF document to w.
+//
+// vertexId must return a unique node ID for every vertex. attribute.String
+// LabelAttribute and attribute.Double WeightAttribute are written as GEXF
+// labels and weights. Attributes, nodes and edges are sorted, so the output
+// is deterministic.
+func Write[V graph.Vertex](
+	w io.Writer,
+	digraph digraph.Digraph[V],
+	store *attribute.Store[V],
+	vertexId func(vertex V) string,
+) error {
+	if w == nil {
+		return errors.New("w == nil")
+	}
+	if digraph == nil {
+		return errors.New("digraph == nil")
+	}
+	if vertexId == nil {
+		return errors.New("vertexId == nil")
+	}
+
+	if store == nil {
+		store = attribute.NewStore[V]()
+	}
+
+	document := document{
+		Attributes: []xml.Attr{
+			{Name: xml.Name{Local: "xmlns"}, Value: namespace},
+			{Name: xml.Name{Local: "version"}, Value: version},
+		},
+		Graph: graphElement{
+			DefaultEdgeType: "directed",
+			AttributeLists:  make([]attributesElement, 0, 2),
+			Nodes:           make([]nodeElement, 0, digraph.Order()),
+			Edges:           make([]edgeElement, 0, digraph.Size()),
+		},
+	}
+
+	for _, prefix := range slices.Sorted(maps.Keys(store.XMLNamespaces)) {
+		document.Attributes = append(document.Attributes, xml.Attr{
+			Name:  xml.Name{Local: "xmlns:" + prefix},
+			Value: store.XMLNamespaces[prefix],
+		})
+	}
+
+	vertexSchema, err := attribute.Schema(store.Vertices)
+
+	if err != nil {
+		return err
+	}
+
+	edgeSchema, err := attribute.Schema(store.Edges)
+
+	if err != nil {
+		return err
+	}
+
+	vertexAttributeIds := addAttributes(&document, "node", vertexSchema)
+	edgeAttributeIds := addAttributes(&document, "edge", edgeSchema)
+
+	for vertex := range digraph.AllVertices() {
+		attributes := store.Vertices[vertex]
+
+		document.Graph.Nodes = append(document.Graph.Nodes, nodeElement{
+			Id:        vertexId(vertex),
+			Label:     label(attributes),
+			AttValues: writeAttValues(attributes, vertexAttributeIds),
+		})
+	}
+
+	for edge := range digraph.AllEdges() {
+		attributes := store.Edges[edge]
+
+		document.Graph.Edges = append(document.Graph.Edges, edgeElement{
+			Source:    vertexId(edge.Source()),
+			Target:    vertexId(edge.Target()),
+			Label:     label(attributes),
+			Weight:    weight(attributes),
+			AttValues: writeAttValues(attributes, edgeAttributeIds),
+		})
+	}
+
+	slices.SortFunc(document.Graph.Nodes, func(u, v nodeElement) int {
+		return cmp.Compare(u.Id, v.Id)
+	})
+	slices.SortFunc(document.Graph.Edges, func(uv, xy edgeElement) int {
+		return cmp.Or(cmp.Compare(uv.Source, xy.Source), cmp.Compare(uv.Target, xy.Target))
+	})
+
+	for i := range document.Graph.Edges {
+		document.Graph.Edges[i].Id = strconv.Itoa(i)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "\n")
+
+	return err
+}
+
+// addAttributes adds an attribute for every attribute in schema (except labels
+// and weights) to document and returns attribute name to attribute id mappings.
+func addAttributes(
+	document *document,
+	class string,
+	schema map[string]attribute.Declaration,
+) map[string]string {
+	nameToId := make(map[string]string, len(schema))
+	attributeList := attributesElement{Class: class, Attributes: make([]attributeElement, 0)}
+
+	for _, name := range slices.Sorted(maps.Keys(schema)) {
+		declaration := schema[name]
+
+		if isLabel(name, declaration) || (class == "edge" && isWeight(name, declaration)) {
+			continue
+		}
+
+		id := strconv.Itoa(len(attributeList.Attributes))
+		typeName := declaration.UnknownType
+
+		if declaration.Type != attribute.Unknown {
+			typeName = typeNames[declaration.Type]
+		}
+
+		attributeList.Attributes = append(attributeList.Attributes, attributeElement{
+			Id:    id,
+			Title: name,
+			Type:  typeName,
+		})
+		nameToId[name] = id
+	}
+
+	if len(attributeList.Attributes) > 0 {
+		document.Graph.AttributeLists = append(document.Graph.AttributeLists, attributeList)
+	}
+
+	return nameToId
+}
+
+func writeAttValues(attributes map[string]attribute.Value, nameToId map[string]string) []attValueElement {
+	attValues := make([]attValueElement, 0, len(attributes))
+
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		if id, isPresent := nameToId[name]; isPresent {
+			attValues = append(attValues, attValueElement{For: id, Value: attributes[name].String()})
+		}
+	}
+
+	return attValues
+}
+
+func label(attributes map[string]attribute.Value) *string {
+	value, isPresent := attributes[LabelAttribute]
+
+	if !isPresent || value.Type != attribute.String {
+		return nil
+	}
+
+	text := value.String()
+
+	return &text
+}
+
+func weight(attributes map[string]attribute.Value) *string {
+	value, isPresent := attributes[WeightAttribute]
+
+	if !isPresent || value.Type != attribute.Double {
+		return nil
+	}
+
+	text := value.String()
+
+	return &text
+}
+
+func isLabel(name string, declaration attribute.Declaration) bool {
+	return name == LabelAttribute && declaration.Type == attribute.String
+}
+
+func isWeight(name string, declaration attribute.Declaration) bool {
+	return name == WeightAttribute && declaration.Type == attribute.Double
+}
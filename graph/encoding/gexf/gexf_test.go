@@ -0,0 +1,119 @@
+package gexf
+
+import (
+	"bytes"
+	"goraph/graph"
+	"goraph/graph/attribute"
+	"strings"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const input = `<?xml version="1.0" encoding="UTF-8"?>
+<gexf xmlns="http://gexf.net/1.3" xmlns:viz="http://gexf.net/1.3/viz" version="1.3">
+  <graph defaultedgetype="directed">
+    <attributes class="node">
+      <attribute id="0" title="population" type="long"/>
+      <attribute id="1" title="capital" type="boolean">
+        <default>false</default>
+      </attribute>
+      <attribute id="2" title="tags" type="liststring"/>
+    </attributes>
+    <attributes class="edge">
+      <attribute id="0" title="lanes" type="integer"/>
+    </attributes>
+    <nodes>
+      <node id="a" label="Alpha">
+        <attvalues>
+          <attvalue for="0" value="1000"/>
+          <attvalue for="1" value="true"/>
+          <attvalue for="2" value="[x, y]"/>
+        </attvalues>
+        <viz:color r="255" g="0" b="0"/>
+      </node>
+      <node id="b">
+        <attvalues>
+          <attvalue for="7" value="undeclared"/>
+        </attvalues>
+      </node>
+    </nodes>
+    <edges>
+      <edge id="0" source="a" target="b" weight="2.5">
+        <attvalues>
+          <attvalue for="0" value="4"/>
+        </attvalues>
+      </edge>
+    </edges>
+  </graph>
+</gexf>
+`
+
+func TestRead(t *testing.T) {
+	simpleDigraph, store, err := Read(strings.NewReader(input))
+
+	assert.Nil(t, err)
+	assert.Equal(t, mapset.NewFromElements("a", "b"), simpleDigraph.Vertices())
+	assert.Equal(t, mapset.NewFromElements(graph.NewEdge("a", "b")), simpleDigraph.Edges())
+
+	assert.Equal(
+		t,
+		map[string]attribute.Value{
+			LabelAttribute: attribute.NewString("Alpha"),
+			"population":   attribute.NewLong(1000),
+			"capital":      attribute.NewBool(true),
+			"tags":         attribute.NewUnknown("liststring", "[x, y]"),
+		},
+		store.Vertices["a"],
+	)
+	assert.Equal(
+		t,
+		map[string]attribute.Value{
+			"capital": attribute.NewBool(false),
+			"7":       attribute.NewUnknown("", "undeclared"),
+		},
+		store.Vertices["b"],
+	)
+	assert.Equal(
+		t,
+		map[string]attribute.Value{
+			WeightAttribute: attribute.NewDouble(2.5),
+			"lanes":         attribute.NewInt(4),
+		},
+		store.Edges[graph.NewEdge("a", "b")],
+	)
+}
+
+func TestWrite_RoundTrip(t *testing.T) {
+	simpleDigraph, store, _ := Read(strings.NewReader(input))
+
+	var buffer bytes.Buffer
+
+	err := Write(&buffer, simpleDigraph, store, func(vertex string) string { return vertex })
+
+	assert.Nil(t, err)
+
+	readSimpleDigraph, readStore, err := Read(&buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, simpleDigraph.Vertices(), readSimpleDigraph.Vertices())
+	assert.Equal(t, simpleDigraph.Edges(), readSimpleDigraph.Edges())
+	assert.Equal(t, store, readStore)
+}
+
+func TestRead_Undirected(t *testing.T) {
+	inputs := []string{
+		`<gexf><graph><nodes><node id="a"/><node id="b"/></nodes><edges><edge id="0" source="a" target="b"/></edges></graph></gexf>`,
+		`<gexf><graph defaultedgetype="directed"><nodes><node id="a"/><node id="b"/></nodes><edges><edge id="0" source="a" target="b" type="mutual"/></edges></graph></gexf>`,
+	}
+
+	for _, input := range inputs {
+		simpleDigraph, store, err := Read(strings.NewReader(input))
+
+		assert.Nil(t, simpleDigraph)
+		assert.Nil(t, store)
+		assert.NotNil(t, err, input)
+	}
+}
@@ -0,0 +1,411 @@
+package graphml
+
+import (
+	"cmp"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/attribute"
+	"goraph/graph/digraph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+const namespace = "http://graphml.graphdrawing.org/xmlns"
+
+type document struct {
+	XMLName    xml.Name       `xml:"graphml"`
+	Attributes []xml.Attr     `xml:",any,attr"`
+	Keys       []keyElement   `xml:"key"`
+	Graphs     []graphElement `xml:"graph"`
+}
+
+type keyElement struct {
+	Id      string  `xml:"id,attr"`
+	For     string  `xml:"for,attr,omitempty"`
+	Name    string  `xml:"attr.name,attr,omitempty"`
+	Type    string  `xml:"attr.type,attr,omitempty"`
+	Default *string `xml:"default"`
+}
+
+type graphElement struct {
+	Id          string        `xml:"id,attr,omitempty"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Data        []dataElement `xml:"data"`
+	Nodes       []nodeElement `xml:"node"`
+	Edges       []edgeElement `xml:"edge"`
+}
+
+type dataElement struct {
+	Key string `xml:"key,attr"`
+
+	// Text is an unescaped text of typed data.
+	Text string `xml:",chardata"`
+
+	// InnerXML is a raw content of untyped data.
+	InnerXML string `xml:",innerxml"`
+}
+
+type nodeElement struct {
+	Id   string        `xml:"id,attr"`
+	Data []dataElement `xml:"data"`
+}
+
+type edgeElement struct {
+	Id       string        `xml:"id,attr,omitempty"`
+	Source   string        `xml:"source,attr"`
+	Target   string        `xml:"target,attr"`
+	Directed string        `xml:"directed,attr,omitempty"`
+	Data     []dataElement `xml:"data"`
+}
+
+// keyDeclaration is a resolved GraphML key.
+type keyDeclaration struct {
+	name         string
+	declaration  attribute.Declaration
+	defaultValue *attribute.Value
+}
+
+var typeNames = map[attribute.Type]string{
+	attribute.Bool:   "boolean",
+	attribute.Int:    "int",
+	attribute.Long:   "long",
+	attribute.Float:  "float",
+	attribute.Double: "double",
+	attribute.String: "string",
+}
+
+// Read reads a GraphML document with a single graph from r and creates an
+// immutable simpledigraph.SimpleDigraph using adjacency list ADT with
+// vertices labeled by node IDs and attribute.Store with their attributes.
+//
+// Data of keys with unknown or missing attr.type (e.g. yEd graphics) and of
+// undeclared keys is kept as attribute.Unknown value with raw inner XML.
+// Key defaults are applied to vertices and edges without data.
+//
+// goraph has no undirected graph type, so undirected edges are rejected.
+//
+// http://graphml.graphdrawing.org/primer/graphml-primer.html
+func Read(r io.Reader) (simpledigraph.SimpleDigraph[string], *attribute.Store[string], error) {
+	if r == nil {
+		return nil, nil, errors.New("r == nil")
+	}
+
+	var document document
+
+	if err := xml.NewDecoder(r).Decode(&document); err != nil {
+		return nil, nil, err
+	}
+
+	if len(document.Graphs) != 1 {
+		return nil, nil, fmt.Errorf("expected 1 graph, got %d", len(document.Graphs))
+	}
+
+	graphXML := document.Graphs[0]
+	store := attribute.NewStore[string]()
+
+	for _, xmlAttribute := range document.Attributes {
+		if xmlAttribute.Name.Space == "xmlns" {
+			store.XMLNamespaces[xmlAttribute.Name.Local] = xmlAttribute.Value
+		}
+	}
+
+	keys, err := resolveKeys(document.Keys)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := readData(graphXML.Data, keys, "graph", store.Graph); err != nil {
+		return nil, nil, err
+	}
+
+	vertices := mapset.New[string]()
+	edges := mapset.New[graph.Edge[string]]()
+
+	for _, node := range graphXML.Nodes {
+		if vertices.Contains(node.Id) {
+			return nil, nil, fmt.Errorf("duplicate node %q", node.Id)
+		}
+
+		vertices.Add(node.Id)
+		store.Vertices[node.Id] = make(map[string]attribute.Value)
+
+		if err := readData(node.Data, keys, "node", store.Vertices[node.Id]); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, edgeXML := range graphXML.Edges {
+		directed := edgeXML.Directed == "true" ||
+			(edgeXML.Directed == "" && graphXML.EdgeDefault == "directed")
+
+		if !directed {
+			return nil, nil, fmt.Errorf(
+				"undirected edge (%s, %s) is not supported",
+				edgeXML.Source,
+				edgeXML.Target,
+			)
+		}
+
+		edge := graph.NewEdge(edgeXML.Source, edgeXML.Target)
+
+		if edges.Contains(edge) {
+			return nil, nil, fmt.Errorf("parallel edge %+v is not supported", edge)
+		}
+
+		edges.Add(edge)
+		store.Edges[edge] = make(map[string]attribute.Value)
+
+		if err := readData(edgeXML.Data, keys, "edge", store.Edges[edge]); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return simpleDigraph, store, nil
+}
+
+func resolveKeys(keys []keyElement) (map[string]map[string]keyDeclaration, error) {
+	// domain -> key id -> keyDeclaration
+	resolvedKeys := map[string]map[string]keyDeclaration{
+		"graph": make(map[string]keyDeclaration),
+		"node":  make(map[string]keyDeclaration),
+		"edge":  make(map[string]keyDeclaration),
+	}
+
+	for _, key := range keys {
+		resolvedKey := keyDeclaration{
+			name:        cmp.Or(key.Name, key.Id),
+			declaration: attribute.Declaration{Type: attribute.Unknown, UnknownType: key.Type},
+		}
+
+		for valueType, typeName := range typeNames {
+			if key.Type == typeName {
+				resolvedKey.declaration = attribute.Declaration{Type: valueType}
+			}
+		}
+
+		if key.Default != nil && resolvedKey.declaration.Type != attribute.Unknown {
+			defaultValue, err := attribute.Parse(resolvedKey.declaration.Type, strings.TrimSpace(*key.Default))
+
+			if err != nil {
+				return nil, fmt.Errorf("default of key %q: %w", key.Id, err)
+			}
+
+			resolvedKey.defaultValue = &defaultValue
+		}
+
+		for domain := range resolvedKeys {
+			if key.For == domain || key.For == "all" || key.For == "" {
+				resolvedKeys[domain][key.Id] = resolvedKey
+			}
+		}
+	}
+
+	return resolvedKeys, nil
+}
+
+func readData(
+	dataList []dataElement,
+	keys map[string]map[string]keyDeclaration,
+	domain string,
+	attributes map[string]attribute.Value,
+) error {
+	for _, key := range keys[domain] {
+		if key.defaultValue != nil {
+			attributes[key.name] = *key.defaultValue
+		}
+	}
+
+	for _, data := range dataList {
+		key, isDeclared := keys[domain][data.Key]
+
+		switch {
+		case !isDeclared:
+			attributes[data.Key] = attribute.NewUnknown("", data.InnerXML)
+
+		case key.declaration.Type == attribute.Unknown:
+			attributes[key.name] = attribute.NewUnknown(key.declaration.UnknownType, data.InnerXML)
+
+		default:
+			text := data.Text
+
+			if key.declaration.Type != attribute.String {
+				text = strings.TrimSpace(text)
+			}
+
+			value, err := attribute.Parse(key.declaration.Type, text)
+
+			if err != nil {
+				return fmt.Errorf("data of key %q: %w", data.Key, err)
+			}
+
+			attributes[key.name] = value
+		}
+	}
+
+	return nil
+}
+
+// Write writes digraph with its attributes from store (which may be nil)
+// as a GraphML document to w.
+//
+// vertexId must return a unique node ID for every vertex. attribute.Unknown
+// values are written as raw inner XML. Keys, nodes and edges are sorted,
+// so the output is deterministic.
+func Write[V graph.Vertex](
+	w io.Writer,
+	digraph digraph.Digraph[V],
+	store *attribute.Store[V],
+	vertexId func(vertex V) string,
+) error {
+	if w == nil {
+		return errors.New("w == nil")
+	}
+	if digraph == nil {
+		return errors.New("digraph == nil")
+	}
+	if vertexId == nil {
+		return errors.New("vertexId == nil")
+	}
+
+	if store == nil {
+		store = attribute.NewStore[V]()
+	}
+
+	document := document{
+		Attributes: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: namespace}},
+		Keys:       make([]keyElement, 0),
+	}
+
+	for _, prefix := range slices.Sorted(maps.Keys(store.XMLNamespaces)) {
+		document.Attributes = append(document.Attributes, xml.Attr{
+			Name:  xml.Name{Local: "xmlns:" + prefix},
+			Value: store.XMLNamespaces[prefix],
+		})
+	}
+
+	graphSchema, err := attribute.GraphSchema(store.Graph)
+
+	if err != nil {
+		return err
+	}
+
+	vertexSchema, err := attribute.Schema(store.Vertices)
+
+	if err != nil {
+		return err
+	}
+
+	edgeSchema, err := attribute.Schema(store.Edges)
+
+	if err != nil {
+		return err
+	}
+
+	graphKeys := addKeys(&document, "graph", "g", graphSchema)
+	vertexKeys := addKeys(&document, "node", "n", vertexSchema)
+	edgeKeys := addKeys(&document, "edge", "e", edgeSchema)
+
+	graphXML := graphElement{
+		EdgeDefault: "directed",
+		Data:        writeData(store.Graph, graphKeys),
+		Nodes:       make([]nodeElement, 0, digraph.Order()),
+		Edges:       make([]edgeElement, 0, digraph.Size()),
+	}
+
+	for vertex := range digraph.AllVertices() {
+		graphXML.Nodes = append(graphXML.Nodes, nodeElement{
+			Id:   vertexId(vertex),
+			Data: writeData(store.Vertices[vertex], vertexKeys),
+		})
+	}
+
+	for edge := range digraph.AllEdges() {
+		graphXML.Edges = append(graphXML.Edges, edgeElement{
+			Source: vertexId(edge.Source()),
+			Target: vertexId(edge.Target()),
+			Data:   writeData(store.Edges[edge], edgeKeys),
+		})
+	}
+
+	slices.SortFunc(graphXML.Nodes, func(u, v nodeElement) int {
+		return cmp.Compare(u.Id, v.Id)
+	})
+	slices.SortFunc(graphXML.Edges, func(uv, xy edgeElement) int {
+		return cmp.Or(cmp.Compare(uv.Source, xy.Source), cmp.Compare(uv.Target, xy.Target))
+	})
+
+	document.Graphs = []graphElement{graphXML}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "\n")
+
+	return err
+}
+
+// addKeys adds a key for every attribute in schema to document and returns
+// attribute name to key id mappings.
+func addKeys(
+	document *document,
+	domain string,
+	idPrefix string,
+	schema map[string]attribute.Declaration,
+) map[string]string {
+	nameToId := make(map[string]string, len(schema))
+
+	for i, name := range slices.Sorted(maps.Keys(schema)) {
+		declaration := schema[name]
+		id := fmt.Sprintf("%s%d", idPrefix, i)
+
+		typeName := declaration.UnknownType
+
+		if declaration.Type != attribute.Unknown {
+			typeName = typeNames[declaration.Type]
+		}
+
+		document.Keys = append(document.Keys, keyElement{Id: id, For: domain, Name: name, Type: typeName})
+		nameToId[name] = id
+	}
+
+	return nameToId
+}
+
+func writeData(attributes map[string]attribute.Value, nameToId map[string]string) []dataElement {
+	dataList := make([]dataElement, 0, len(attributes))
+
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		value := attributes[name]
+
+		if value.Type == attribute.Unknown {
+			dataList = append(dataList, dataElement{Key: nameToId[name], InnerXML: value.String()})
+		} else {
+			dataList = append(dataList, dataElement{Key: nameToId[name], Text: value.String()})
+		}
+	}
+
+	return dataList
+}
@@ -0,0 +1,110 @@
+package graphml
+
+import (
+	"bytes"
+	"goraph/graph"
+	"goraph/graph/attribute"
+	"strings"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const input = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns" xmlns:y="http://www.yworks.com/xml/graphml">
+  <key id="d0" for="node" attr.name="color" attr.type="string">
+    <default>yellow</default>
+  </key>
+  <key id="d1" for="edge" attr.name="weight" attr.type="double"/>
+  <key id="d2" for="node" yfiles.type="nodegraphics"/>
+  <key id="d3" for="graph" attr.name="name" attr.type="string"/>
+  <graph id="G" edgedefault="directed">
+    <data key="d3">example</data>
+    <node id="n0">
+      <data key="d0">green</data>
+      <data key="d2"><y:ShapeNode><y:Shape type="ellipse"/></y:ShapeNode></data>
+    </node>
+    <node id="n1"/>
+    <node id="n2">
+      <data key="d9">undeclared</data>
+    </node>
+    <edge source="n0" target="n1">
+      <data key="d1">1.5</data>
+    </edge>
+    <edge source="n1" target="n2"/>
+  </graph>
+</graphml>
+`
+
+func TestRead(t *testing.T) {
+	simpleDigraph, store, err := Read(strings.NewReader(input))
+
+	assert.Nil(t, err)
+	assert.Equal(t, mapset.NewFromElements("n0", "n1", "n2"), simpleDigraph.Vertices())
+	assert.Equal(
+		t,
+		mapset.NewFromElements(graph.NewEdge("n0", "n1"), graph.NewEdge("n1", "n2")),
+		simpleDigraph.Edges(),
+	)
+
+	assert.Equal(t, map[string]attribute.Value{"name": attribute.NewString("example")}, store.Graph)
+	assert.Equal(
+		t,
+		map[string]attribute.Value{
+			"color": attribute.NewString("green"),
+			"d2":    attribute.NewUnknown("", `<y:ShapeNode><y:Shape type="ellipse"/></y:ShapeNode>`),
+		},
+		store.Vertices["n0"],
+	)
+	assert.Equal(t, map[string]attribute.Value{"color": attribute.NewString("yellow")}, store.Vertices["n1"])
+	assert.Equal(
+		t,
+		map[string]attribute.Value{
+			"color": attribute.NewString("yellow"),
+			"d9":    attribute.NewUnknown("", "undeclared"),
+		},
+		store.Vertices["n2"],
+	)
+	assert.Equal(
+		t,
+		map[string]attribute.Value{"weight": attribute.NewDouble(1.5)},
+		store.Edges[graph.NewEdge("n0", "n1")],
+	)
+	assert.Equal(t, "http://www.yworks.com/xml/graphml", store.XMLNamespaces["y"])
+}
+
+func TestWrite_RoundTrip(t *testing.T) {
+	simpleDigraph, store, _ := Read(strings.NewReader(input))
+
+	var buffer bytes.Buffer
+
+	err := Write(&buffer, simpleDigraph, store, func(vertex string) string { return vertex })
+
+	assert.Nil(t, err)
+	assert.Contains(t, buffer.String(), `xmlns:y="http://www.yworks.com/xml/graphml"`)
+	assert.Contains(t, buffer.String(), `<y:ShapeNode><y:Shape type="ellipse"/></y:ShapeNode>`)
+
+	readSimpleDigraph, readStore, err := Read(&buffer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, simpleDigraph.Vertices(), readSimpleDigraph.Vertices())
+	assert.Equal(t, simpleDigraph.Edges(), readSimpleDigraph.Edges())
+	assert.Equal(t, store, readStore)
+}
+
+func TestRead_Undirected(t *testing.T) {
+	inputs := []string{
+		`<graphml><graph edgedefault="undirected"><node id="a"/><node id="b"/><edge source="a" target="b"/></graph></graphml>`,
+		`<graphml><graph edgedefault="directed"><node id="a"/><node id="b"/><edge source="a" target="b" directed="false"/></graph></graphml>`,
+	}
+
+	for _, input := range inputs {
+		simpleDigraph, store, err := Read(strings.NewReader(input))
+
+		assert.Nil(t, simpleDigraph)
+		assert.Nil(t, store)
+		assert.NotNil(t, err, input)
+	}
+}
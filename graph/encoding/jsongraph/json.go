@@ -0,0 +1,207 @@
+package jsongraph
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	mf "goraph/maxflow"
+	"slices"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// SimpleDigraphJSON is a JSON schema of simpledigraph.SimpleDigraph:
+//
+//	{"vertices": [...], "edges": [{"source": ..., "target": ...}, ...]}
+type SimpleDigraphJSON[V graph.Vertex] struct {
+	Vertices []V             `json:"vertices"`
+	Edges    []graph.Edge[V] `json:"edges"`
+}
+
+// SimpleFlowNetworkJSON is a JSON schema of mf.SimpleFlowNetwork:
+//
+//	{
+//	  "vertices": [...],
+//	  "edges": [{"source": ..., "target": ..., "capacity": ..., "flow": ...}, ...],
+//	  "s": ...,
+//	  "t": ...
+//	}
+type SimpleFlowNetworkJSON[V graph.Vertex] struct {
+	Vertices []V               `json:"vertices"`
+	Edges    []FlowEdgeJSON[V] `json:"edges"`
+	S        V                 `json:"s"`
+	T        V                 `json:"t"`
+}
+
+// FlowEdgeJSON is a JSON schema of an edge of mf.SimpleFlowNetwork.
+type FlowEdgeJSON[V graph.Vertex] struct {
+	Source   V      `json:"source"`
+	Target   V      `json:"target"`
+	Capacity uint32 `json:"capacity"`
+	Flow     uint32 `json:"flow"`
+}
+
+// MarshalSimpleDigraph encodes simpleDigraph as SimpleDigraphJSON.
+//
+// Vertices and edges are sorted by their JSON encodings,
+// so the output is deterministic.
+func MarshalSimpleDigraph[V graph.Vertex](
+	simpleDigraph simpledigraph.SimpleDigraph[V],
+) ([]byte, error) {
+	if simpleDigraph == nil {
+		return nil, errors.New("simpleDigraph == nil")
+	}
+
+	vertices, err := sortedByJSON(slices.Collect(simpleDigraph.AllVertices()))
+
+	if err != nil {
+		return nil, err
+	}
+
+	edges, err := sortedByJSON(slices.Collect(simpleDigraph.AllEdges()))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(SimpleDigraphJSON[V]{vertices, edges})
+}
+
+// UnmarshalSimpleDigraph decodes SimpleDigraphJSON and creates an immutable
+// simpledigraph.SimpleDigraph using adjacency list ADT.
+func UnmarshalSimpleDigraph[V graph.Vertex](data []byte) (simpledigraph.SimpleDigraph[V], error) {
+	var decoded SimpleDigraphJSON[V]
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+
+	return al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(decoded.Vertices...),
+		mapset.NewFromElements(decoded.Edges...),
+	)
+}
+
+// MarshalSimpleFlowNetwork encodes network as SimpleFlowNetworkJSON.
+//
+// Vertices and edges are sorted by their JSON encodings,
+// so the output is deterministic.
+func MarshalSimpleFlowNetwork[V graph.Vertex](network *mf.SimpleFlowNetwork[V]) ([]byte, error) {
+	if network == nil {
+		return nil, errors.New("network == nil")
+	}
+	if network.SimpleDigraph == nil {
+		return nil, errors.New("network.SimpleDigraph == nil")
+	}
+
+	vertices, err := sortedByJSON(slices.Collect(network.AllVertices()))
+
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]FlowEdgeJSON[V], 0, network.Size())
+
+	for edge := range network.AllEdges() {
+		edges = append(edges, FlowEdgeJSON[V]{
+			Source:   edge.Source(),
+			Target:   edge.Target(),
+			Capacity: network.Capacity[edge],
+			Flow:     network.Flow[edge],
+		})
+	}
+
+	edges, err = sortedByJSON(edges)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(SimpleFlowNetworkJSON[V]{vertices, edges, network.S, network.T})
+}
+
+// UnmarshalSimpleFlowNetwork decodes SimpleFlowNetworkJSON and creates
+// mf.SimpleFlowNetwork which simpledigraph.SimpleDigraph uses adjacency list ADT.
+func UnmarshalSimpleFlowNetwork[V graph.Vertex](data []byte) (*mf.SimpleFlowNetwork[V], error) {
+	var decoded SimpleFlowNetworkJSON[V]
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+
+	edges := mapset.New[graph.Edge[V]]()
+	capacity := make(mf.Capacity[V], len(decoded.Edges))
+	flow := make(mf.Flow[V], len(decoded.Edges))
+
+	for _, flowEdge := range decoded.Edges {
+		edge := graph.NewEdge(flowEdge.Source, flowEdge.Target)
+
+		if edges.Contains(edge) {
+			return nil, errors.New("duplicate edges are not allowed")
+		}
+
+		if flowEdge.Flow > flowEdge.Capacity {
+			return nil, errors.New("edge flow > edge capacity")
+		}
+
+		edges.Add(edge)
+		capacity[edge] = flowEdge.Capacity
+		flow[edge] = flowEdge.Flow
+	}
+
+	vertices := mapset.NewFromElements(decoded.Vertices...)
+
+	if !vertices.Contains(decoded.S) || !vertices.Contains(decoded.T) {
+		return nil, errors.New("s or t is not present in vertices")
+	}
+
+	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mf.SimpleFlowNetwork[V]{
+		SimpleDigraph: simpleDigraph,
+		S:             decoded.S,
+		T:             decoded.T,
+		Capacity:      capacity,
+		Flow:          flow,
+	}, nil
+}
+
+// sortedByJSON sorts elements by their JSON encodings.
+func sortedByJSON[T any](elements []T) ([]T, error) {
+	encodings := make([][]byte, len(elements))
+
+	for i, element := range elements {
+		encoding, err := json.Marshal(element)
+
+		if err != nil {
+			return nil, err
+		}
+
+		encodings[i] = encoding
+	}
+
+	indices := make([]int, len(elements))
+
+	for i := range indices {
+		indices[i] = i
+	}
+
+	slices.SortFunc(indices, func(i, j int) int {
+		return bytes.Compare(encodings[i], encodings[j])
+	})
+
+	sorted := make([]T, len(elements))
+
+	for i, index := range indices {
+		sorted[i] = elements[index]
+	}
+
+	return sorted, nil
+}
@@ -0,0 +1,114 @@
+package jsongraph
+
+import (
+	"goraph/graph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	mf "goraph/maxflow"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalSimpleDigraph(t *testing.T) {
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3),
+		mapset.NewFromElements(graph.NewEdge(2, 3), graph.NewEdge(1, 2)),
+	)
+
+	data, err := MarshalSimpleDigraph(simpleDigraph)
+
+	assert.Nil(t, err)
+	assert.JSONEq(
+		t,
+		`{
+			"vertices": [1, 2, 3],
+			"edges": [{"source": 1, "target": 2}, {"source": 2, "target": 3}]
+		}`,
+		string(data),
+	)
+
+	unmarshaledSimpleDigraph, err := UnmarshalSimpleDigraph[int](data)
+
+	assert.Nil(t, err)
+	assert.Equal(t, simpleDigraph.Vertices(), unmarshaledSimpleDigraph.Vertices())
+	assert.Equal(t, simpleDigraph.Edges(), unmarshaledSimpleDigraph.Edges())
+}
+
+func TestUnmarshalSimpleDigraph_Errors(t *testing.T) {
+	inputs := []string{
+		`{"vertices": [1], "edges": [{"source": 1, "target": 1}]}`,
+		`{"vertices": [1], "edges": [{"source": 1, "target": 2}]}`,
+		`{"vertices": ["1"]}`,
+	}
+
+	for _, input := range inputs {
+		simpleDigraph, err := UnmarshalSimpleDigraph[int]([]byte(input))
+
+		assert.Nil(t, simpleDigraph)
+		assert.NotNil(t, err, input)
+	}
+}
+
+func TestMarshalSimpleFlowNetwork(t *testing.T) {
+	sa, at := graph.NewEdge("s", "a"), graph.NewEdge("a", "t")
+
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements("s", "a", "t"),
+		mapset.NewFromElements(sa, at),
+	)
+
+	network := &mf.SimpleFlowNetwork[string]{
+		SimpleDigraph: simpleDigraph,
+		S:             "s",
+		T:             "t",
+		Capacity:      mf.Capacity[string]{sa: 3, at: 2},
+		Flow:          mf.Flow[string]{sa: 1, at: 1},
+	}
+
+	data, err := MarshalSimpleFlowNetwork(network)
+
+	assert.Nil(t, err)
+	assert.JSONEq(
+		t,
+		`{
+			"vertices": ["a", "s", "t"],
+			"edges": [
+				{"source": "a", "target": "t", "capacity": 2, "flow": 1},
+				{"source": "s", "target": "a", "capacity": 3, "flow": 1}
+			],
+			"s": "s",
+			"t": "t"
+		}`,
+		string(data),
+	)
+
+	unmarshaledNetwork, err := UnmarshalSimpleFlowNetwork[string](data)
+
+	assert.Nil(t, err)
+	assert.Equal(t, network.Vertices(), unmarshaledNetwork.Vertices())
+	assert.Equal(t, network.Edges(), unmarshaledNetwork.Edges())
+	assert.Equal(t, network.S, unmarshaledNetwork.S)
+	assert.Equal(t, network.T, unmarshaledNetwork.T)
+	assert.Equal(t, network.Capacity, unmarshaledNetwork.Capacity)
+	assert.Equal(t, network.Flow, unmarshaledNetwork.Flow)
+}
+
+func TestUnmarshalSimpleFlowNetwork_Errors(t *testing.T) {
+	inputs := []string{
+		`{"vertices": ["s", "t"], "edges": [{"source": "s", "target": "t", "capacity": 1, "flow": 2}], "s": "s", "t": "t"}`,
+		`{"vertices": ["s", "t"], "edges": [], "s": "s", "t": "x"}`,
+		`{"vertices": ["s", "t"], "edges": [
+			{"source": "s", "target": "t", "capacity": 1, "flow": 0},
+			{"source": "s", "target": "t", "capacity": 2, "flow": 0}
+		], "s": "s", "t": "t"}`,
+	}
+
+	for _, input := range inputs {
+		network, err := UnmarshalSimpleFlowNetwork[string]([]byte(input))
+
+		assert.Nil(t, network)
+		assert.NotNil(t, err, input)
+	}
+}
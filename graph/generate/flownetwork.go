@@ -0,0 +1,227 @@
+package generate
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	mf "goraph/maxflow"
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// NewFlowNetwork creates mf.SimpleFlowNetwork on top of simpleDigraph with
+// source s, sink t, capacities chosen uniformly at random from
+// [1, maxCapacity] and zero flow.
+func NewFlowNetwork(
+	simpleDigraph simpledigraph.SimpleDigraph[int],
+	s int,
+	t int,
+	maxCapacity uint32,
+	rng *rand.Rand,
+) (*mf.SimpleFlowNetwork[int], error) {
+	if err := assertRandIsNotNil(rng); err != nil {
+		return nil, err
+	}
+	if simpleDigraph == nil {
+		return nil, errors.New("simpleDigraph == nil")
+	}
+	if maxCapacity == 0 {
+		return nil, errors.New("maxCapacity == 0")
+	}
+
+	vertices := simpleDigraph.Vertices()
+
+	if !vertices.Contains(s) || !vertices.Contains(t) {
+		return nil, fmt.Errorf("s = %d or t = %d is not present in simpleDigraph", s, t)
+	}
+	if s == t {
+		return nil, errors.New("s == t")
+	}
+
+	// edges are sorted, so that capacities don't depend on iteration order
+	edges := slices.SortedFunc(simpleDigraph.AllEdges(), func(uv, xy graph.Edge[int]) int {
+		return cmp.Or(cmp.Compare(uv.Source(), xy.Source()), cmp.Compare(uv.Target(), xy.Target()))
+	})
+
+	capacity := make(mf.Capacity[int], len(edges))
+
+	for _, edge := range edges {
+		capacity[edge] = rng.Uint32N(maxCapacity) + 1
+	}
+
+	return newFlowNetwork(simpleDigraph, s, t, capacity), nil
+}
+
+// RandomFlowNetwork generates mf.SimpleFlowNetwork on top of ErdosRenyiGnm
+// simpledigraph.SimpleDigraph with distinct random source and sink,
+// capacities chosen uniformly at random from [1, maxCapacity] and zero flow.
+func RandomFlowNetwork(
+	amountOfVertices int,
+	amountOfEdges int,
+	maxCapacity uint32,
+	rng *rand.Rand,
+) (*mf.SimpleFlowNetwork[int], error) {
+	if amountOfVertices < 2 {
+		return nil, errors.New("amountOfVertices < 2")
+	}
+
+	simpleDigraph, err := ErdosRenyiGnm(amountOfVertices, amountOfEdges, rng)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := rng.IntN(amountOfVertices) + 1
+	t := rng.IntN(amountOfVertices-1) + 1
+
+	// t is chosen among amountOfVertices - 1 vertices other than s
+	if t >= s {
+		t++
+	}
+
+	return NewFlowNetwork(simpleDigraph, s, t, maxCapacity, rng)
+}
+
+// AK generates a max flow network modeled after the AK family of
+// Cherkassky and Goldberg, which is hard both for augmenting path and
+// push-relabel algorithms. It has 4k + 6 vertices and 6k + 7 edges,
+// s = 1 and t = 4k + 6, and consists of 2 modules of n = 2k + 2 vertices:
+//
+//   - a "staircase" s -> a_1 -> ... -> a_n with capacity n - i + 1 of the
+//     i-th edge and an edge a_i -> t with capacity 1 from every a_i, so
+//     it needs n augmenting paths of increasing length;
+//   - a long path s -> b_1 -> ... -> b_n -> t with capacity n, so the
+//     flow has to be pushed a long way.
+//
+// Here a_i = i + 1 and b_i = n + i + 1.
+//
+// https://doi.org/10.1007/3-540-59408-6_49
+func AK(k int) (*mf.SimpleFlowNetwork[int], error) {
+	if k < 1 {
+		return nil, errors.New("k < 1")
+	}
+
+	n := 2*k + 2
+	s := 1
+	t := 2*n + 2
+
+	a := func(i int) int { return i + 1 }
+	b := func(i int) int { return n + i + 1 }
+
+	capacity := make(mf.Capacity[int], 6*k+7)
+	edges := make([]graph.Edge[int], 0, 6*k+7)
+
+	addEdge := func(u, v int, uvCapacity int) {
+		uv := graph.NewEdge(u, v)
+
+		edges = append(edges, uv)
+		capacity[uv] = uint32(uvCapacity)
+	}
+
+	addEdge(s, a(1), n)
+	addEdge(s, b(1), n)
+
+	for i := 1; i <= n; i++ {
+		addEdge(a(i), t, 1)
+
+		if i < n {
+			addEdge(a(i), a(i+1), n-i)
+			addEdge(b(i), b(i+1), n)
+		}
+	}
+
+	addEdge(b(n), t, n)
+
+	simpleDigraph, err := newSimpleDigraph(t, edges)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newFlowNetwork(simpleDigraph, s, t, capacity), nil
+}
+
+// Genrmf generates a max flow network of the RMFGEN family of Goldfarb and
+// Grigoriadis. It consists of b frames, every frame is an a x a grid
+// with edges to and from neighbors with capacity c2 * a * a, and every
+// vertex of frame f has an edge to a vertex of frame f + 1 (chosen by
+// random permutation) with capacity chosen uniformly at random from [c1, c2].
+//
+// Vertex (x, y) of frame f with 0 <= x, y < a and 0 <= f < b is labeled
+// (f*a + x)*a + y + 1, s = 1 is the first vertex of the first frame and
+// t = a * a * b is the last vertex of the last frame.
+//
+// https://doi.org/10.1007/BFb0121090
+func Genrmf(a int, b int, c1 uint32, c2 uint32, rng *rand.Rand) (*mf.SimpleFlowNetwork[int], error) {
+	if err := assertRandIsNotNil(rng); err != nil {
+		return nil, err
+	}
+	if a < 1 || b < 1 || a*a*b < 2 {
+		return nil, errors.New("a < 1 or b < 1 or network has less than 2 vertices")
+	}
+	if c1 < 1 || c1 > c2 {
+		return nil, errors.New("c1 < 1 or c1 > c2")
+	}
+	if uint64(c2)*uint64(a*a) > math.MaxUint32 {
+		return nil, errors.New("c2 * a * a overflows uint32")
+	}
+
+	frame, err := Grid2D(a, a)
+
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]graph.Edge[int], 0, b*frame.Size()+a*a*(b-1))
+	capacity := make(mf.Capacity[int], cap(edges))
+
+	for f := 0; f < b; f++ {
+		for edge := range frame.AllEdges() {
+			uv := graph.NewEdge(f*a*a+edge.Source(), f*a*a+edge.Target())
+
+			edges = append(edges, uv)
+			capacity[uv] = c2 * uint32(a*a)
+		}
+	}
+
+	for f := 0; f+1 < b; f++ {
+		for i, j := range rng.Perm(a * a) {
+			uv := graph.NewEdge(f*a*a+i+1, (f+1)*a*a+j+1)
+
+			edges = append(edges, uv)
+			capacity[uv] = c1 + rng.Uint32N(c2-c1+1)
+		}
+	}
+
+	simpleDigraph, err := newSimpleDigraph(a*a*b, edges)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newFlowNetwork(simpleDigraph, 1, a*a*b, capacity), nil
+}
+
+func newFlowNetwork(
+	simpleDigraph simpledigraph.SimpleDigraph[int],
+	s int,
+	t int,
+	capacity mf.Capacity[int],
+) *mf.SimpleFlowNetwork[int] {
+	flow := make(mf.Flow[int], len(capacity))
+
+	for edge := range capacity {
+		flow[edge] = 0
+	}
+
+	return &mf.SimpleFlowNetwork[int]{
+		SimpleDigraph: simpleDigraph,
+		S:             s,
+		T:             t,
+		Capacity:      capacity,
+		Flow:          flow,
+	}
+}
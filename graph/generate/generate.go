@@ -0,0 +1,79 @@
+// Package generate provides seeded generators of random and structured
+// simpledigraph.SimpleDigraph and maxflow.SimpleFlowNetwork instances
+// for property tests, benchmarks and load tests.
+//
+// Every generator labels vertices from 1 to the amount of vertices and
+// draws all random numbers from the given *rand.Rand, so the same seed
+// always produces the same graph. Generated graphs use CSR ADT, they are
+// immutable and thread-safe, and iterate vertices in label order and edges
+// ordered by source and then by target, so the same seed also produces
+// the same iteration order.
+package generate
+
+import (
+	"errors"
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	"goraph/graph/digraph/simpledigraph/csr"
+	"math/rand/v2"
+	"slices"
+)
+
+// NewRand creates *rand.Rand seeded with seed, which is the usual
+// source of randomness for generators.
+func NewRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+func newVertices(amountOfVertices int) []int {
+	vertices := make([]int, amountOfVertices)
+
+	for i := range vertices {
+		vertices[i] = i + 1
+	}
+
+	return vertices
+}
+
+func newSimpleDigraph(amountOfVertices int, edges []graph.Edge[int]) (simpledigraph.SimpleDigraph[int], error) {
+	return csr.NewOrderedCSRSimpleDigraph(newVertices(amountOfVertices), slices.Values(edges))
+}
+
+func assertRandIsNotNil(rng *rand.Rand) error {
+	if rng == nil {
+		return errors.New("rng == nil")
+	}
+
+	return nil
+}
+
+func assertIsNonNegative(name string, value int) error {
+	if value < 0 {
+		return fmt.Errorf("%s < 0", name)
+	}
+
+	return nil
+}
+
+func assertIsProbability(name string, value float64) error {
+	if !(value >= 0 && value <= 1) {
+		return fmt.Errorf("%s is not in [0, 1]", name)
+	}
+
+	return nil
+}
+
+// edgeOfPairIndex maps index in [0, n * (n - 1)) to the corresponding
+// ordered pair (u, v) of distinct vertices in [1, n].
+func edgeOfPairIndex(amountOfVertices int, index int64) graph.Edge[int] {
+	u := int(index/int64(amountOfVertices-1)) + 1
+	v := int(index%int64(amountOfVertices-1)) + 1
+
+	// skip the loop (u, u)
+	if v >= u {
+		v++
+	}
+
+	return graph.NewEdge(u, v)
+}
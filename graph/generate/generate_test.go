@@ -0,0 +1,250 @@
+package generate
+
+import (
+	"cmp"
+	"goraph/graph"
+	mf "goraph/maxflow"
+	"goraph/maxflow/edmondskarp"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErdosRenyiGnp(t *testing.T) {
+	simpleDigraph, err := ErdosRenyiGnp(100, 0.1, NewRand(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 100, simpleDigraph.Order())
+	assert.InDelta(t, 990, simpleDigraph.Size(), 150)
+
+	sameSimpleDigraph, _ := ErdosRenyiGnp(100, 0.1, NewRand(1))
+
+	assert.Equal(t, simpleDigraph.Edges(), sameSimpleDigraph.Edges())
+
+	// the same graph is iterated in the same, label order
+	assert.Equal(t, slices.Collect(simpleDigraph.AllEdges()), slices.Collect(sameSimpleDigraph.AllEdges()))
+	assert.True(t, slices.IsSorted(slices.Collect(simpleDigraph.AllVertices())))
+	assert.True(t, slices.IsSortedFunc(slices.Collect(simpleDigraph.AllEdges()), func(uv, xy graph.Edge[int]) int {
+		return cmp.Or(cmp.Compare(uv.Source(), xy.Source()), cmp.Compare(uv.Target(), xy.Target()))
+	}))
+
+	complete, err := ErdosRenyiGnp(10, 1, NewRand(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 90, complete.Size())
+
+	empty, err := ErdosRenyiGnp(10, 0, NewRand(1))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, empty.Size())
+
+	_, err = ErdosRenyiGnp(10, 1.5, NewRand(1))
+
+	assert.NotNil(t, err)
+
+	_, err = ErdosRenyiGnp(10, 0.5, nil)
+
+	assert.NotNil(t, err)
+}
+
+func TestErdosRenyiGnm(t *testing.T) {
+	for _, amountOfEdges := range []int{0, 1, 45, 89, 90} {
+		simpleDigraph, err := ErdosRenyiGnm(10, amountOfEdges, NewRand(2))
+
+		assert.Nil(t, err)
+		assert.Equal(t, 10, simpleDigraph.Order())
+		assert.Equal(t, amountOfEdges, simpleDigraph.Size())
+	}
+
+	simpleDigraph, _ := ErdosRenyiGnm(50, 300, NewRand(3))
+	sameSimpleDigraph, _ := ErdosRenyiGnm(50, 300, NewRand(3))
+
+	assert.Equal(t, simpleDigraph.Edges(), sameSimpleDigraph.Edges())
+
+	_, err := ErdosRenyiGnm(10, 91, NewRand(2))
+
+	assert.NotNil(t, err)
+}
+
+func TestBarabasiAlbert(t *testing.T) {
+	simpleDigraph, err := BarabasiAlbert(100, 3, NewRand(4))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 100, simpleDigraph.Order())
+	assert.Equal(t, 97*3, simpleDigraph.Size())
+
+	for edge := range simpleDigraph.AllEdges() {
+		assert.Greater(t, edge.Source(), edge.Target())
+	}
+
+	_, err = BarabasiAlbert(3, 3, NewRand(4))
+
+	assert.NotNil(t, err)
+}
+
+func TestWattsStrogatz(t *testing.T) {
+	lattice, err := WattsStrogatz(10, 2, 0, NewRand(5))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 20, lattice.Size())
+	assert.NotNil(t, lattice.Edge(10, 1))
+	assert.NotNil(t, lattice.Edge(10, 2))
+
+	rewired, err := WattsStrogatz(100, 4, 0.5, NewRand(5))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 400, rewired.Size())
+
+	for u := range rewired.AllVertices() {
+		assert.Equal(t, 4, rewired.OutDegree(u))
+	}
+
+	_, err = WattsStrogatz(10, 10, 0.5, NewRand(5))
+
+	assert.NotNil(t, err)
+
+	_, err = WattsStrogatz(-1, 0, 0.5, NewRand(5))
+
+	assert.NotNil(t, err)
+}
+
+func TestRandomDAG(t *testing.T) {
+	simpleDigraph, err := RandomDAG(50, 0.3, NewRand(6))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 50, simpleDigraph.Order())
+
+	for edge := range simpleDigraph.AllEdges() {
+		assert.Less(t, edge.Source(), edge.Target())
+	}
+}
+
+func TestRandomBipartite(t *testing.T) {
+	simpleDigraph, err := RandomBipartite(5, 7, 0.5, NewRand(7))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 12, simpleDigraph.Order())
+
+	for edge := range simpleDigraph.AllEdges() {
+		assert.LessOrEqual(t, edge.Source(), 5)
+		assert.Greater(t, edge.Target(), 5)
+	}
+}
+
+func TestComplete(t *testing.T) {
+	simpleDigraph, err := Complete(6)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 6, simpleDigraph.Order())
+	assert.Equal(t, 30, simpleDigraph.Size())
+
+	empty, err := Complete(0)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, empty.Order())
+}
+
+func TestCompleteBipartite(t *testing.T) {
+	simpleDigraph, err := CompleteBipartite(2, 3)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 5, simpleDigraph.Order())
+	assert.Equal(t, 6, simpleDigraph.Size())
+	assert.NotNil(t, simpleDigraph.Edge(2, 5))
+	assert.Nil(t, simpleDigraph.Edge(5, 2))
+}
+
+func TestGrid(t *testing.T) {
+	grid2D, err := Grid2D(3, 4)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 12, grid2D.Order())
+	assert.Equal(t, 2*(3*3+2*4), grid2D.Size())
+	assert.NotNil(t, grid2D.Edge(1, 2))
+	assert.NotNil(t, grid2D.Edge(5, 1))
+	assert.Nil(t, grid2D.Edge(4, 5))
+
+	grid3D, err := Grid3D(2, 2, 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 8, grid3D.Order())
+	assert.Equal(t, 2*12, grid3D.Size())
+	assert.NotNil(t, grid3D.Edge(1, 5))
+}
+
+func TestNewFlowNetwork(t *testing.T) {
+	simpleDigraph, _ := Complete(4)
+
+	network, err := NewFlowNetwork(simpleDigraph, 1, 4, 10, NewRand(8))
+
+	assert.Nil(t, err)
+	assert.Len(t, network.Capacity, 12)
+	assert.Len(t, network.Flow, 12)
+
+	for edge := range simpleDigraph.AllEdges() {
+		assert.GreaterOrEqual(t, network.Capacity[edge], uint32(1))
+		assert.LessOrEqual(t, network.Capacity[edge], uint32(10))
+		assert.Equal(t, uint32(0), network.Flow[edge])
+	}
+
+	sameNetwork, _ := NewFlowNetwork(simpleDigraph, 1, 4, 10, NewRand(8))
+
+	assert.Equal(t, network.Capacity, sameNetwork.Capacity)
+
+	_, err = NewFlowNetwork(simpleDigraph, 1, 1, 10, NewRand(8))
+
+	assert.NotNil(t, err)
+
+	_, err = NewFlowNetwork(simpleDigraph, 1, 5, 10, NewRand(8))
+
+	assert.NotNil(t, err)
+}
+
+func TestRandomFlowNetwork(t *testing.T) {
+	network, err := RandomFlowNetwork(20, 100, 1000, NewRand(9))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 100, network.Size())
+	assert.NotEqual(t, network.S, network.T)
+}
+
+func TestAK(t *testing.T) {
+	k := 3
+	network, err := AK(k)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 4*k+6, network.Order())
+	assert.Equal(t, 6*k+7, network.Size())
+	assert.Equal(t, 4*k+4, maxFlowValue(t, network))
+}
+
+func TestGenrmf(t *testing.T) {
+	a, b := 3, 4
+	network, err := Genrmf(a, b, 1, 10, NewRand(10))
+
+	assert.Nil(t, err)
+	assert.Equal(t, a*a*b, network.Order())
+	assert.Equal(t, b*2*2*a*(a-1)+(b-1)*a*a, network.Size())
+	assert.Equal(t, 1, network.S)
+	assert.Equal(t, a*a*b, network.T)
+	assert.Greater(t, maxFlowValue(t, network), 0)
+}
+
+func maxFlowValue(t *testing.T, network *mf.SimpleFlowNetwork[int]) int {
+	maxFlow, err := edmondskarp.NewEdmondsKarp[int]().Compute(network)
+
+	assert.Nil(t, err)
+
+	value := 0
+
+	for v := range network.SuccessorsSeq(network.S) {
+		value += int(maxFlow[*network.Edge(network.S, v)])
+	}
+
+	for u := range network.PredecessorsSeq(network.S) {
+		value -= int(maxFlow[*network.Edge(u, network.S)])
+	}
+
+	return value
+}
@@ -0,0 +1,280 @@
+package generate
+
+import (
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	"math"
+	"math/rand/v2"
+)
+
+// ErdosRenyiGnp generates a simpledigraph.SimpleDigraph with amountOfVertices
+// vertices where every ordered pair of distinct vertices is an edge
+// with probability p independently.
+//
+// Geometric skipping is used, so it works in O(n + m) expected time.
+//
+// https://en.wikipedia.org/wiki/Erd%C5%91s%E2%80%93R%C3%A9nyi_model
+func ErdosRenyiGnp(amountOfVertices int, p float64, rng *rand.Rand) (simpledigraph.SimpleDigraph[int], error) {
+	if err := assertRandIsNotNil(rng); err != nil {
+		return nil, err
+	}
+	if err := assertIsNonNegative("amountOfVertices", amountOfVertices); err != nil {
+		return nil, err
+	}
+	if err := assertIsProbability("p", p); err != nil {
+		return nil, err
+	}
+
+	amountOfPairs := int64(amountOfVertices) * int64(amountOfVertices-1)
+	edges := make([]graph.Edge[int], 0)
+
+	if p == 0 {
+		return newSimpleDigraph(amountOfVertices, edges)
+	}
+
+	logQ := math.Log(1 - p)
+
+	for index := int64(-1); ; {
+		skip := 0.0
+
+		// log(1 - p) == -Inf iff p == 1, then no pair is skipped
+		if p < 1 {
+			skip = math.Floor(math.Log(1-rng.Float64()) / logQ)
+		}
+
+		if skip >= float64(amountOfPairs-index-1) {
+			break
+		}
+
+		index += int64(skip) + 1
+		edges = append(edges, edgeOfPairIndex(amountOfVertices, index))
+	}
+
+	return newSimpleDigraph(amountOfVertices, edges)
+}
+
+// ErdosRenyiGnm generates a simpledigraph.SimpleDigraph with amountOfVertices
+// vertices and amountOfEdges edges chosen uniformly at random among all
+// ordered pairs of distinct vertices.
+//
+// Floyd's sampling algorithm is used, so it works in O(n + m) time
+// regardless of density.
+//
+// https://en.wikipedia.org/wiki/Erd%C5%91s%E2%80%93R%C3%A9nyi_model
+func ErdosRenyiGnm(amountOfVertices int, amountOfEdges int, rng *rand.Rand) (simpledigraph.SimpleDigraph[int], error) {
+	if err := assertRandIsNotNil(rng); err != nil {
+		return nil, err
+	}
+	if err := assertIsNonNegative("amountOfVertices", amountOfVertices); err != nil {
+		return nil, err
+	}
+	if err := assertIsNonNegative("amountOfEdges", amountOfEdges); err != nil {
+		return nil, err
+	}
+
+	amountOfPairs := int64(amountOfVertices) * int64(amountOfVertices-1)
+
+	if int64(amountOfEdges) > amountOfPairs {
+		return nil, fmt.Errorf(
+			"amountOfEdges > %d (amount of ordered pairs of distinct vertices)",
+			amountOfPairs,
+		)
+	}
+
+	chosenIndices := make(map[int64]struct{}, amountOfEdges)
+	edges := make([]graph.Edge[int], 0, amountOfEdges)
+
+	for j := amountOfPairs - int64(amountOfEdges); j < amountOfPairs; j++ {
+		index := rng.Int64N(j + 1)
+
+		if _, isChosen := chosenIndices[index]; isChosen {
+			index = j
+		}
+
+		chosenIndices[index] = struct{}{}
+		edges = append(edges, edgeOfPairIndex(amountOfVertices, index))
+	}
+
+	return newSimpleDigraph(amountOfVertices, edges)
+}
+
+// BarabasiAlbert generates a scale-free simpledigraph.SimpleDigraph with
+// amountOfVertices vertices using preferential attachment: vertices
+// 1..m start without edges, then every next vertex gets edges to m distinct
+// earlier vertices chosen with probability proportional to their degree.
+//
+// https://en.wikipedia.org/wiki/Barab%C3%A1si%E2%80%93Albert_model
+func BarabasiAlbert(amountOfVertices int, m int, rng *rand.Rand) (simpledigraph.SimpleDigraph[int], error) {
+	if err := assertRandIsNotNil(rng); err != nil {
+		return nil, err
+	}
+	if m < 1 || m >= amountOfVertices {
+		return nil, fmt.Errorf("m = %d is not in [1, amountOfVertices)", m)
+	}
+
+	edges := make([]graph.Edge[int], 0, (amountOfVertices-m)*m)
+
+	targets := make([]int, m)
+
+	for i := range targets {
+		targets[i] = i + 1
+	}
+
+	// every vertex occurs in it as many times as its degree,
+	// so uniform choice from it is preferential attachment
+	repeatedVertices := make([]int, 0, 2*cap(edges))
+
+	for source := m + 1; source <= amountOfVertices; source++ {
+		for _, target := range targets {
+			edges = append(edges, graph.NewEdge(source, target))
+			repeatedVertices = append(repeatedVertices, target, source)
+		}
+
+		targets = targets[:0]
+		isTarget := make(map[int]struct{}, m)
+
+		for len(targets) < m {
+			target := repeatedVertices[rng.IntN(len(repeatedVertices))]
+
+			if _, isPresent := isTarget[target]; !isPresent {
+				isTarget[target] = struct{}{}
+				targets = append(targets, target)
+			}
+		}
+	}
+
+	return newSimpleDigraph(amountOfVertices, edges)
+}
+
+// WattsStrogatz generates a small-world simpledigraph.SimpleDigraph with
+// amountOfVertices vertices: it starts with a ring lattice where every
+// vertex has edges to its k successors on the ring, then the target of every
+// edge is rewired with probability beta to a uniformly random vertex, so
+// that no loops and duplicate edges appear.
+//
+// https://en.wikipedia.org/wiki/Watts%E2%80%93Strogatz_model
+func WattsStrogatz(
+	amountOfVertices int,
+	k int,
+	beta float64,
+	rng *rand.Rand,
+) (simpledigraph.SimpleDigraph[int], error) {
+	if err := assertRandIsNotNil(rng); err != nil {
+		return nil, err
+	}
+	if err := assertIsNonNegative("amountOfVertices", amountOfVertices); err != nil {
+		return nil, err
+	}
+	if k < 0 || (k > 0 && k >= amountOfVertices) {
+		return nil, fmt.Errorf("k = %d is not in [0, amountOfVertices)", k)
+	}
+	if err := assertIsProbability("beta", beta); err != nil {
+		return nil, err
+	}
+
+	edges := make([]graph.Edge[int], 0, amountOfVertices*k)
+
+	for u := 1; u <= amountOfVertices; u++ {
+		successors := make([]int, k)
+		isSuccessor := make(map[int]struct{}, k)
+
+		for j := range successors {
+			successors[j] = (u+j)%amountOfVertices + 1
+			isSuccessor[successors[j]] = struct{}{}
+		}
+
+		for j, v := range successors {
+			// u can't be rewired if it is already connected to all other vertices
+			if rng.Float64() >= beta || len(isSuccessor) == amountOfVertices-1 {
+				continue
+			}
+
+			w := rng.IntN(amountOfVertices) + 1
+			_, wIsSuccessor := isSuccessor[w]
+
+			for w == u || wIsSuccessor {
+				w = rng.IntN(amountOfVertices) + 1
+				_, wIsSuccessor = isSuccessor[w]
+			}
+
+			delete(isSuccessor, v)
+			isSuccessor[w] = struct{}{}
+			successors[j] = w
+		}
+
+		for _, v := range successors {
+			edges = append(edges, graph.NewEdge(u, v))
+		}
+	}
+
+	return newSimpleDigraph(amountOfVertices, edges)
+}
+
+// RandomDAG generates an acyclic simpledigraph.SimpleDigraph with
+// amountOfVertices vertices where every pair u < v is an edge (u, v)
+// with probability p independently, so 1, 2, ..., amountOfVertices is
+// its topological order.
+//
+// https://en.wikipedia.org/wiki/Directed_acyclic_graph
+func RandomDAG(amountOfVertices int, p float64, rng *rand.Rand) (simpledigraph.SimpleDigraph[int], error) {
+	if err := assertRandIsNotNil(rng); err != nil {
+		return nil, err
+	}
+	if err := assertIsNonNegative("amountOfVertices", amountOfVertices); err != nil {
+		return nil, err
+	}
+	if err := assertIsProbability("p", p); err != nil {
+		return nil, err
+	}
+
+	edges := make([]graph.Edge[int], 0)
+
+	for u := 1; u <= amountOfVertices; u++ {
+		for v := u + 1; v <= amountOfVertices; v++ {
+			if rng.Float64() < p {
+				edges = append(edges, graph.NewEdge(u, v))
+			}
+		}
+	}
+
+	return newSimpleDigraph(amountOfVertices, edges)
+}
+
+// RandomBipartite generates a bipartite simpledigraph.SimpleDigraph with
+// left part 1..leftSize and right part leftSize+1..leftSize+rightSize where
+// every pair of left u and right v is an edge (u, v) with probability p
+// independently.
+//
+// https://en.wikipedia.org/wiki/Bipartite_graph
+func RandomBipartite(
+	leftSize int,
+	rightSize int,
+	p float64,
+	rng *rand.Rand,
+) (simpledigraph.SimpleDigraph[int], error) {
+	if err := assertRandIsNotNil(rng); err != nil {
+		return nil, err
+	}
+	if err := assertIsNonNegative("leftSize", leftSize); err != nil {
+		return nil, err
+	}
+	if err := assertIsNonNegative("rightSize", rightSize); err != nil {
+		return nil, err
+	}
+	if err := assertIsProbability("p", p); err != nil {
+		return nil, err
+	}
+
+	edges := make([]graph.Edge[int], 0)
+
+	for u := 1; u <= leftSize; u++ {
+		for v := leftSize + 1; v <= leftSize+rightSize; v++ {
+			if rng.Float64() < p {
+				edges = append(edges, graph.NewEdge(u, v))
+			}
+		}
+	}
+
+	return newSimpleDigraph(leftSize+rightSize, edges)
+}
@@ -0,0 +1,106 @@
+package generate
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+)
+
+// Complete generates a complete simpledigraph.SimpleDigraph with
+// amountOfVertices vertices, so every ordered pair of distinct vertices
+// is an edge.
+//
+// https://en.wikipedia.org/wiki/Complete_graph
+func Complete(amountOfVertices int) (simpledigraph.SimpleDigraph[int], error) {
+	if err := assertIsNonNegative("amountOfVertices", amountOfVertices); err != nil {
+		return nil, err
+	}
+
+	edges := make([]graph.Edge[int], 0, amountOfVertices*max(amountOfVertices-1, 0))
+
+	for u := 1; u <= amountOfVertices; u++ {
+		for v := 1; v <= amountOfVertices; v++ {
+			if u != v {
+				edges = append(edges, graph.NewEdge(u, v))
+			}
+		}
+	}
+
+	return newSimpleDigraph(amountOfVertices, edges)
+}
+
+// CompleteBipartite generates a complete bipartite simpledigraph.SimpleDigraph
+// with left part 1..leftSize and right part leftSize+1..leftSize+rightSize,
+// so every pair of left u and right v is an edge (u, v).
+//
+// https://en.wikipedia.org/wiki/Complete_bipartite_graph
+func CompleteBipartite(leftSize int, rightSize int) (simpledigraph.SimpleDigraph[int], error) {
+	if err := assertIsNonNegative("leftSize", leftSize); err != nil {
+		return nil, err
+	}
+	if err := assertIsNonNegative("rightSize", rightSize); err != nil {
+		return nil, err
+	}
+
+	edges := make([]graph.Edge[int], 0, leftSize*rightSize)
+
+	for u := 1; u <= leftSize; u++ {
+		for v := leftSize + 1; v <= leftSize+rightSize; v++ {
+			edges = append(edges, graph.NewEdge(u, v))
+		}
+	}
+
+	return newSimpleDigraph(leftSize+rightSize, edges)
+}
+
+// Grid2D generates a rows x columns grid simpledigraph.SimpleDigraph where
+// cell (i, j) with 0 <= i < rows and 0 <= j < columns is labeled
+// i*columns + j + 1 and has edges to and from its (up to 4) neighbors.
+//
+// https://en.wikipedia.org/wiki/Lattice_graph
+func Grid2D(rows int, columns int) (simpledigraph.SimpleDigraph[int], error) {
+	return Grid3D(1, rows, columns)
+}
+
+// Grid3D generates a layers x rows x columns grid simpledigraph.SimpleDigraph
+// where cell (l, i, j) with 0 <= l < layers, 0 <= i < rows and
+// 0 <= j < columns is labeled (l*rows + i)*columns + j + 1 and has edges
+// to and from its (up to 6) neighbors.
+//
+// https://en.wikipedia.org/wiki/Lattice_graph
+func Grid3D(layers int, rows int, columns int) (simpledigraph.SimpleDigraph[int], error) {
+	if err := assertIsNonNegative("layers", layers); err != nil {
+		return nil, err
+	}
+	if err := assertIsNonNegative("rows", rows); err != nil {
+		return nil, err
+	}
+	if err := assertIsNonNegative("columns", columns); err != nil {
+		return nil, err
+	}
+
+	cell := func(l, i, j int) int {
+		return (l*rows+i)*columns + j + 1
+	}
+
+	edges := make([]graph.Edge[int], 0, 6*layers*rows*columns)
+
+	for l := 0; l < layers; l++ {
+		for i := 0; i < rows; i++ {
+			for j := 0; j < columns; j++ {
+				u := cell(l, i, j)
+
+				if l+1 < layers {
+					edges = append(edges, graph.NewEdge(u, cell(l+1, i, j)), graph.NewEdge(cell(l+1, i, j), u))
+				}
+				if i+1 < rows {
+					edges = append(edges, graph.NewEdge(u, cell(l, i+1, j)), graph.NewEdge(cell(l, i+1, j), u))
+				}
+				if j+1 < columns {
+					edges = append(edges, graph.NewEdge(u, cell(l, i, j+1)), graph.NewEdge(cell(l, i, j+1), u))
+				}
+			}
+		}
+	}
+
+	return newSimpleDigraph(layers*rows*columns, edges)
+}
@@ -0,0 +1,53 @@
+package graph
+
+import "iter"
+
+// Indexer maps every Vertex to dense int index from 0 to Len() - 1 and back,
+// so algorithms can use slices instead of maps keyed by Vertex internally.
+//
+// This implementation is immutable and thread-safe.
+type Indexer[V Vertex] struct {
+	vertexToIndex map[V]int
+	indexToVertex []V
+}
+
+// NewIndexer creates an Indexer that assigns indices to vertices
+// in the order they are yielded by vertices iter.Seq.
+//
+// Repeated vertices get the index of their first occurrence.
+func NewIndexer[V Vertex](vertices iter.Seq[V]) *Indexer[V] {
+	indexer := &Indexer[V]{
+		vertexToIndex: make(map[V]int),
+		indexToVertex: make([]V, 0),
+	}
+
+	for vertex := range vertices {
+		if _, isPresent := indexer.vertexToIndex[vertex]; isPresent {
+			continue
+		}
+
+		indexer.vertexToIndex[vertex] = len(indexer.indexToVertex)
+		indexer.indexToVertex = append(indexer.indexToVertex, vertex)
+	}
+
+	return indexer
+}
+
+// Index returns index of vertex and true iff vertex is present in this Indexer.
+func (indexer *Indexer[V]) Index(vertex V) (int, bool) {
+	index, isPresent := indexer.vertexToIndex[vertex]
+
+	return index, isPresent
+}
+
+// Vertex returns Vertex with specified index.
+//
+// It panics if index is not in [0, Len()).
+func (indexer *Indexer[V]) Vertex(index int) V {
+	return indexer.indexToVertex[index]
+}
+
+// Len returns the amount of vertices in this Indexer.
+func (indexer *Indexer[V]) Len() int {
+	return len(indexer.indexToVertex)
+}
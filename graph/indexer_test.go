@@ -0,0 +1,25 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexer(t *testing.T) {
+	indexer := NewIndexer(slices.Values([]string{"A", "B", "A", "C"}))
+
+	assert.Equal(t, 3, indexer.Len())
+
+	for index, vertex := range []string{"A", "B", "C"} {
+		actualIndex, isPresent := indexer.Index(vertex)
+
+		assert.True(t, isPresent)
+		assert.Equal(t, index, actualIndex)
+		assert.Equal(t, vertex, indexer.Vertex(index))
+	}
+
+	_, isPresent := indexer.Index("D")
+	assert.False(t, isPresent)
+}
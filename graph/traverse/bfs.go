@@ -0,0 +1,85 @@
+package traverse
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph"
+
+	"github.com/nikolai-kramskoy/go-data-structures/queue/slicequeue"
+)
+
+// BreadthFirstSearch runs breadth-first search in digraph from all sources
+// simultaneously (they all have level 0), so Result.Level of every reached
+// vertex is its distance in edges from the nearest source.
+//
+// It panics if digraph is nil or some source is not present in digraph.
+//
+// https://en.wikipedia.org/wiki/Breadth-first_search
+func BreadthFirstSearch[V graph.Vertex](
+	digraph digraph.Digraph[V],
+	sources []V,
+	visitor Visitor[V],
+	options Options,
+) *Result[V] {
+	assertPreconditions(digraph, sources)
+
+	result := newResult[V]()
+	time := 0
+	vertexQueue := slicequeue.New[V]()
+
+	discover := func(vertex V, level int) bool {
+		result.Discovery[vertex] = time
+		result.Level[vertex] = level
+		time++
+		vertexQueue.Push(vertex)
+
+		return call(visitor.DiscoverVertex, vertex)
+	}
+
+	for _, source := range sources {
+		if !result.IsReached(source) && !discover(source, 0) {
+			result.Stopped = true
+
+			return result
+		}
+	}
+
+	for !vertexQueue.IsEmpty() {
+		u := vertexQueue.Pop()
+		uLevel := result.Level[u]
+
+		if options.DepthLimit == 0 || uLevel < options.DepthLimit {
+			for neighbor := range neighbors(digraph, u, options.Direction) {
+				v := neighbor.vertex
+
+				if result.IsReached(v) {
+					if !call(visitor.NonTreeEdge, neighbor.edge) {
+						result.Stopped = true
+
+						return result
+					}
+
+					continue
+				}
+
+				result.Predecessor[v] = u
+
+				if !call(visitor.TreeEdge, neighbor.edge) || !discover(v, uLevel+1) {
+					result.Stopped = true
+
+					return result
+				}
+			}
+		}
+
+		result.Finish[u] = time
+		time++
+
+		if !call(visitor.FinishVertex, u) {
+			result.Stopped = true
+
+			return result
+		}
+	}
+
+	return result
+}
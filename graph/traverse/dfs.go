@@ -0,0 +1,122 @@
+package traverse
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph"
+	"slices"
+)
+
+// dfsFrame is a vertex on the depth-first search stack with its
+// neighbors that have not been examined yet.
+type dfsFrame[V graph.Vertex] struct {
+	vertex    V
+	neighbors []neighbor[V]
+}
+
+// DepthFirstSearch runs depth-first search in digraph from every source
+// in order that has not been reached from previous sources and classifies
+// edges as tree, back, forward and cross edges.
+//
+// The search uses an explicit stack, so it doesn't overflow the goroutine
+// stack on long paths.
+//
+// It panics if digraph is nil or some source is not present in digraph.
+//
+// https://en.wikipedia.org/wiki/Depth-first_search
+func DepthFirstSearch[V graph.Vertex](
+	digraph digraph.Digraph[V],
+	sources []V,
+	visitor Visitor[V],
+	options Options,
+) *Result[V] {
+	assertPreconditions(digraph, sources)
+
+	result := newResult[V]()
+	time := 0
+	stack := make([]dfsFrame[V], 0)
+
+	discover := func(vertex V, level int) bool {
+		result.Discovery[vertex] = time
+		result.Level[vertex] = level
+		time++
+
+		frame := dfsFrame[V]{vertex: vertex}
+
+		if options.DepthLimit == 0 || level < options.DepthLimit {
+			frame.neighbors = slices.Collect(neighbors(digraph, vertex, options.Direction))
+		}
+
+		stack = append(stack, frame)
+
+		return call(visitor.DiscoverVertex, vertex)
+	}
+
+	// classify returns the callback for non-tree edge uv
+	classify := func(u, v V) func(graph.Edge[V]) bool {
+		_, vIsFinished := result.Finish[v]
+
+		switch {
+		case !vIsFinished:
+			return visitor.BackEdge
+		case result.Discovery[u] < result.Discovery[v]:
+			return visitor.ForwardEdge
+		default:
+			return visitor.CrossEdge
+		}
+	}
+
+	for _, source := range sources {
+		if result.IsReached(source) {
+			continue
+		}
+
+		if !discover(source, 0) {
+			result.Stopped = true
+
+			return result
+		}
+
+		for len(stack) > 0 {
+			frame := &stack[len(stack)-1]
+			u := frame.vertex
+
+			if len(frame.neighbors) == 0 {
+				stack = stack[:len(stack)-1]
+				result.Finish[u] = time
+				time++
+
+				if !call(visitor.FinishVertex, u) {
+					result.Stopped = true
+
+					return result
+				}
+
+				continue
+			}
+
+			neighbor := frame.neighbors[0]
+			frame.neighbors = frame.neighbors[1:]
+			v := neighbor.vertex
+
+			if result.IsReached(v) {
+				if !call(classify(u, v), neighbor.edge) {
+					result.Stopped = true
+
+					return result
+				}
+
+				continue
+			}
+
+			result.Predecessor[v] = u
+
+			if !call(visitor.TreeEdge, neighbor.edge) || !discover(v, result.Level[u]+1) {
+				result.Stopped = true
+
+				return result
+			}
+		}
+	}
+
+	return result
+}
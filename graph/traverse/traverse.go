@@ -0,0 +1,174 @@
+// Package traverse provides breadth-first and depth-first search over
+// digraph.Digraph with visitor callbacks.
+package traverse
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph"
+	"iter"
+	"slices"
+)
+
+// Direction defines which edges a search follows from a vertex.
+type Direction int
+
+const (
+	// Out follows edges from a vertex to its successors.
+	Out Direction = iota
+
+	// In follows edges from a vertex to its predecessors backwards.
+	In
+
+	// Both follows edges to successors and then to predecessors.
+	Both
+)
+
+// Options configures a search.
+type Options struct {
+	Direction Direction
+
+	// DepthLimit > 0 makes a search not expand vertices at this depth
+	// (sources have depth 0), so no vertex deeper than DepthLimit is
+	// discovered. 0 means no limit.
+	DepthLimit int
+}
+
+// Visitor holds callbacks that a search calls, every callback may be nil.
+//
+// If a callback returns false, the search stops immediately and
+// Result.Stopped is true.
+//
+// Edges are passed to callbacks as they are in digraph.Digraph, so with In
+// direction the search goes from edge.Target() to edge.Source().
+type Visitor[V graph.Vertex] struct {
+	// DiscoverVertex is called when vertex is reached for the first time.
+	DiscoverVertex func(vertex V) bool
+
+	// FinishVertex is called when all edges of vertex have been examined.
+	FinishVertex func(vertex V) bool
+
+	// TreeEdge is called for an edge that discovers a vertex.
+	TreeEdge func(edge graph.Edge[V]) bool
+
+	// BackEdge is called by DepthFirstSearch for an edge to an ancestor
+	// in the depth-first forest.
+	BackEdge func(edge graph.Edge[V]) bool
+
+	// ForwardEdge is called by DepthFirstSearch for a non-tree edge to
+	// a descendant in the depth-first forest.
+	ForwardEdge func(edge graph.Edge[V]) bool
+
+	// CrossEdge is called by DepthFirstSearch for all other edges.
+	CrossEdge func(edge graph.Edge[V]) bool
+
+	// NonTreeEdge is called by BreadthFirstSearch for an edge to an already
+	// discovered vertex.
+	NonTreeEdge func(edge graph.Edge[V]) bool
+}
+
+// Result holds the search forest and timestamps of reached vertices.
+type Result[V graph.Vertex] struct {
+	// Predecessor maps every reached vertex except sources to its parent
+	// in the search forest.
+	Predecessor map[V]V
+
+	// Discovery maps every reached vertex to the time it was discovered.
+	Discovery map[V]int
+
+	// Finish maps every finished vertex to the time it was finished.
+	//
+	// Discovery and Finish share the same clock, which ticks once per event.
+	Finish map[V]int
+
+	// Level maps every reached vertex to its depth in the search forest.
+	Level map[V]int
+
+	// Stopped is true iff some Visitor callback returned false.
+	Stopped bool
+}
+
+func newResult[V graph.Vertex]() *Result[V] {
+	return &Result[V]{
+		Predecessor: make(map[V]V),
+		Discovery:   make(map[V]int),
+		Finish:      make(map[V]int),
+		Level:       make(map[V]int),
+	}
+}
+
+// IsReached returns true iff vertex was discovered by the search.
+func (result *Result[V]) IsReached(vertex V) bool {
+	_, isReached := result.Discovery[vertex]
+
+	return isReached
+}
+
+// PathTo returns vertices on the path from a source to vertex in the
+// search forest or nil if vertex has not been reached.
+func (result *Result[V]) PathTo(vertex V) []V {
+	if !result.IsReached(vertex) {
+		return nil
+	}
+
+	path := []V{vertex}
+	predecessor, isPresent := result.Predecessor[vertex]
+
+	for isPresent {
+		path = append(path, predecessor)
+		predecessor, isPresent = result.Predecessor[predecessor]
+	}
+
+	slices.Reverse(path)
+
+	return path
+}
+
+// neighbor is a vertex adjacent to some vertex u in a search
+// together with the edge between them.
+type neighbor[V graph.Vertex] struct {
+	vertex V
+	edge   graph.Edge[V]
+}
+
+func neighbors[V graph.Vertex](
+	digraph digraph.Digraph[V],
+	u V,
+	direction Direction,
+) iter.Seq[neighbor[V]] {
+	return func(yield func(neighbor[V]) bool) {
+		if direction == Out || direction == Both {
+			for v := range digraph.SuccessorsSeq(u) {
+				if !yield(neighbor[V]{v, graph.NewEdge(u, v)}) {
+					return
+				}
+			}
+		}
+
+		if direction == In || direction == Both {
+			for v := range digraph.PredecessorsSeq(u) {
+				if !yield(neighbor[V]{v, graph.NewEdge(v, u)}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func assertPreconditions[V graph.Vertex](digraph digraph.Digraph[V], sources []V) {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+
+	vertices := digraph.Vertices()
+
+	for _, source := range sources {
+		if !vertices.Contains(source) {
+			panic("source is not present in digraph")
+		}
+	}
+}
+
+// call calls callback if it is not nil.
+func call[T any](callback func(T) bool, argument T) bool {
+	return callback == nil || callback(argument)
+}
@@ -0,0 +1,189 @@
+package traverse
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+// 1 -> 2 -> 3 -> 4
+// |         ^    |
+// +---------+    v
+// 2 <-------------
+// 5 is isolated
+func newTestSimpleDigraph() simpledigraph.SimpleDigraph[int] {
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4, 5),
+		mapset.NewFromElements(
+			graph.NewEdge(1, 2),
+			graph.NewEdge(2, 3),
+			graph.NewEdge(3, 4),
+			graph.NewEdge(1, 3),
+			graph.NewEdge(4, 2),
+		),
+	)
+
+	return simpleDigraph
+}
+
+func TestBreadthFirstSearch(t *testing.T) {
+	simpleDigraph := newTestSimpleDigraph()
+	treeEdges := mapset.New[graph.Edge[int]]()
+	nonTreeEdges := mapset.New[graph.Edge[int]]()
+
+	result := BreadthFirstSearch(
+		simpleDigraph,
+		[]int{1},
+		Visitor[int]{
+			TreeEdge:    func(edge graph.Edge[int]) bool { treeEdges.Add(edge); return true },
+			NonTreeEdge: func(edge graph.Edge[int]) bool { nonTreeEdges.Add(edge); return true },
+		},
+		Options{},
+	)
+
+	assert.False(t, result.Stopped)
+	assert.Equal(t, map[int]int{1: 0, 2: 1, 3: 1, 4: 2}, result.Level)
+	assert.Equal(t, []int{1, 3, 4}, result.PathTo(4))
+	assert.Nil(t, result.PathTo(5))
+	assert.Equal(t, mapset.NewFromElements(graph.NewEdge(1, 2), graph.NewEdge(1, 3), graph.NewEdge(3, 4)), treeEdges)
+	assert.Equal(t, mapset.NewFromElements(graph.NewEdge(2, 3), graph.NewEdge(4, 2)), nonTreeEdges)
+
+	for vertex := range result.Discovery {
+		assert.Less(t, result.Discovery[vertex], result.Finish[vertex])
+	}
+}
+
+func TestBreadthFirstSearch_Options(t *testing.T) {
+	simpleDigraph := newTestSimpleDigraph()
+
+	in := BreadthFirstSearch(simpleDigraph, []int{4}, Visitor[int]{}, Options{Direction: In})
+
+	assert.Equal(t, map[int]int{4: 0, 3: 1, 2: 2, 1: 2}, in.Level)
+
+	both := BreadthFirstSearch(simpleDigraph, []int{2}, Visitor[int]{}, Options{Direction: Both})
+
+	assert.Equal(t, map[int]int{2: 0, 3: 1, 1: 1, 4: 1}, both.Level)
+
+	limited := BreadthFirstSearch(simpleDigraph, []int{1}, Visitor[int]{}, Options{DepthLimit: 1})
+
+	assert.False(t, limited.IsReached(4))
+	assert.True(t, limited.IsReached(3))
+
+	many := BreadthFirstSearch(simpleDigraph, []int{5, 4}, Visitor[int]{}, Options{})
+
+	assert.Equal(t, map[int]int{5: 0, 4: 0, 2: 1, 3: 2}, many.Level)
+}
+
+func TestBreadthFirstSearch_Stop(t *testing.T) {
+	result := BreadthFirstSearch(
+		newTestSimpleDigraph(),
+		[]int{1},
+		Visitor[int]{DiscoverVertex: func(vertex int) bool { return vertex != 3 }},
+		Options{},
+	)
+
+	assert.True(t, result.Stopped)
+	assert.True(t, result.IsReached(3))
+	assert.False(t, result.IsReached(4))
+}
+
+func TestDepthFirstSearch(t *testing.T) {
+	simpleDigraph := newTestSimpleDigraph()
+	edgeTypes := make(map[graph.Edge[int]]string)
+
+	record := func(edgeType string) func(graph.Edge[int]) bool {
+		return func(edge graph.Edge[int]) bool {
+			edgeTypes[edge] = edgeType
+
+			return true
+		}
+	}
+
+	result := DepthFirstSearch(
+		simpleDigraph,
+		[]int{1, 5},
+		Visitor[int]{
+			TreeEdge:    record("tree"),
+			BackEdge:    record("back"),
+			ForwardEdge: record("forward"),
+			CrossEdge:   record("cross"),
+		},
+		Options{},
+	)
+
+	assert.False(t, result.Stopped)
+	assert.Len(t, edgeTypes, 5)
+	assert.Equal(t, "tree", edgeTypes[graph.NewEdge(3, 4)])
+
+	// the cycle 2 -> 3 -> 4 -> 2 is closed by a back edge into its first vertex
+	if result.Predecessor[2] == 1 {
+		assert.Equal(t, "back", edgeTypes[graph.NewEdge(4, 2)])
+	} else {
+		assert.Equal(t, "back", edgeTypes[graph.NewEdge(2, 3)])
+	}
+
+	// 1 -> 3 is either a tree or a forward edge depending on successor order
+	if edgeTypes[graph.NewEdge(1, 2)] == "tree" && result.Predecessor[3] == 2 {
+		assert.Equal(t, "forward", edgeTypes[graph.NewEdge(1, 3)])
+	}
+
+	assert.Equal(t, 0, result.Level[5])
+	assert.Equal(t, []int{5}, result.PathTo(5))
+
+	// parenthesis theorem
+	for u := range result.Discovery {
+		for v, vPredecessor := range result.Predecessor {
+			if vPredecessor == u {
+				assert.Less(t, result.Discovery[u], result.Discovery[v])
+				assert.Less(t, result.Finish[v], result.Finish[u])
+			}
+		}
+	}
+}
+
+func TestDepthFirstSearch_Options(t *testing.T) {
+	simpleDigraph := newTestSimpleDigraph()
+
+	limited := DepthFirstSearch(simpleDigraph, []int{2}, Visitor[int]{}, Options{DepthLimit: 1})
+
+	assert.True(t, limited.IsReached(3))
+	assert.False(t, limited.IsReached(4))
+
+	in := DepthFirstSearch(simpleDigraph, []int{2}, Visitor[int]{}, Options{Direction: In})
+
+	assert.Equal(t, []int{2, 4, 3}, in.PathTo(3))
+
+	stopped := DepthFirstSearch(
+		simpleDigraph,
+		[]int{1},
+		Visitor[int]{FinishVertex: func(int) bool { return false }},
+		Options{},
+	)
+
+	assert.True(t, stopped.Stopped)
+	assert.Len(t, stopped.Finish, 1)
+}
+
+func TestDepthFirstSearch_LongPath(t *testing.T) {
+	amountOfVertices := 100_000
+	vertices := mapset.New[int]()
+	edges := mapset.New[graph.Edge[int]]()
+
+	for vertex := 1; vertex <= amountOfVertices; vertex++ {
+		vertices.Add(vertex)
+
+		if vertex > 1 {
+			edges.Add(graph.NewEdge(vertex-1, vertex))
+		}
+	}
+
+	path, _ := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	result := DepthFirstSearch(path, []int{1}, Visitor[int]{}, Options{})
+
+	assert.Equal(t, amountOfVertices-1, result.Level[amountOfVertices])
+}
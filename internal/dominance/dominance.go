@@ -0,0 +1,169 @@
+// Package dominance computes dominator trees of flow graphs with vertices
+// 0, 1, ..., n - 1 for dominator and connectivity packages.
+package dominance
+
+// Undefined is the immediate dominator of a vertex which is not reached yet.
+const Undefined = -1
+
+// dfsFrame is a vertex on the depth-first search stack with the position
+// of its next successor to examine.
+type dfsFrame struct {
+	u             int
+	nextSuccessor int
+}
+
+// Postorder returns vertices reachable from entry in postorder of
+// depth-first search from entry, so entry is the last of them.
+func Postorder(successors [][]int, entry int) []int {
+	postorder := make([]int, 0, len(successors))
+	isVisited := make([]bool, len(successors))
+	isVisited[entry] = true
+	callStack := []dfsFrame{{u: entry}}
+
+	for len(callStack) > 0 {
+		frame := &callStack[len(callStack)-1]
+
+		if frame.nextSuccessor < len(successors[frame.u]) {
+			v := successors[frame.u][frame.nextSuccessor]
+			frame.nextSuccessor++
+
+			if !isVisited[v] {
+				isVisited[v] = true
+				callStack = append(callStack, dfsFrame{u: v})
+			}
+
+			continue
+		}
+
+		postorder = append(postorder, frame.u)
+		callStack = callStack[:len(callStack)-1]
+	}
+
+	return postorder
+}
+
+// CooperHarveyKennedy returns immediate dominators of a flow graph given by
+// predecessors of every vertex and postorder of depth-first search from
+// the entry, which every vertex must be reachable from. The immediate
+// dominator of the entry is the entry itself.
+//
+// It's an iterative data-flow algorithm over reverse postorder that
+// intersects dominators of predecessors in the tree being built.
+//
+// Time complexity: O(V * E) in the worst case.
+//
+// https://www.cs.tufts.edu/comp/150FP/archive/keith-cooper/dom14.pdf
+func CooperHarveyKennedy(predecessors [][]int, postorder []int) []int {
+	n := len(postorder)
+	entry := postorder[n-1]
+
+	postorderNumber := make([]int, len(predecessors))
+	idom := make([]int, len(predecessors))
+
+	for i, v := range postorder {
+		postorderNumber[v] = i
+		idom[v] = Undefined
+	}
+
+	idom[entry] = entry
+
+	intersect := func(a, b int) int {
+		for a != b {
+			for postorderNumber[a] < postorderNumber[b] {
+				a = idom[a]
+			}
+			for postorderNumber[b] < postorderNumber[a] {
+				b = idom[b]
+			}
+		}
+
+		return a
+	}
+
+	for isChanged := true; isChanged; {
+		isChanged = false
+
+		// reverse postorder without the entry, which is the last
+		for i := n - 2; i >= 0; i-- {
+			v := postorder[i]
+			newIdom := Undefined
+
+			for _, p := range predecessors[v] {
+				switch {
+				case idom[p] == Undefined:
+				case newIdom == Undefined:
+					newIdom = p
+				default:
+					newIdom = intersect(p, newIdom)
+				}
+			}
+
+			if idom[v] != newIdom {
+				idom[v] = newIdom
+				isChanged = true
+			}
+		}
+	}
+
+	return idom
+}
+
+// Tree is a dominator tree that answers dominance queries in O(1) time.
+type Tree struct {
+	// Idom maps vertex to its immediate dominator, the entry to itself.
+	Idom []int
+
+	// Children maps vertex to vertices which immediate dominator it is.
+	Children [][]int
+
+	// enter and exit are preorder and the greatest preorder number in
+	// the subtree of every vertex in the Tree.
+	enter []int
+	exit  []int
+}
+
+// NewTree creates Tree with immediate dominators idom and the entry.
+func NewTree(idom []int, entry int) *Tree {
+	n := len(idom)
+
+	tree := &Tree{
+		Idom:     idom,
+		Children: make([][]int, n),
+		enter:    make([]int, n),
+		exit:     make([]int, n),
+	}
+
+	for v, u := range idom {
+		if v != entry {
+			tree.Children[u] = append(tree.Children[u], v)
+		}
+	}
+
+	time := 0
+	callStack := []dfsFrame{{u: entry}}
+
+	for len(callStack) > 0 {
+		frame := &callStack[len(callStack)-1]
+
+		if frame.nextSuccessor < len(tree.Children[frame.u]) {
+			v := tree.Children[frame.u][frame.nextSuccessor]
+			frame.nextSuccessor++
+
+			time++
+			tree.enter[v] = time
+			callStack = append(callStack, dfsFrame{u: v})
+
+			continue
+		}
+
+		tree.exit[frame.u] = time
+		callStack = callStack[:len(callStack)-1]
+	}
+
+	return tree
+}
+
+// Dominates returns true iff u dominates v. Every vertex dominates itself.
+func (tree *Tree) Dominates(u, v int) bool {
+	return tree.enter[u] <= tree.enter[v] && tree.exit[v] <= tree.exit[u]
+}
@@ -0,0 +1,34 @@
+package dominance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCooperHarveyKennedy(t *testing.T) {
+	// 0 -> 1, 0 -> 2, 1 -> 3, 2 -> 3, 3 -> 4, 4 -> 1
+	successors := [][]int{{1, 2}, {3}, {3}, {4}, {1}}
+	predecessors := [][]int{{}, {0, 4}, {0}, {1, 2}, {3}}
+
+	postorder := Postorder(successors, 0)
+
+	assert.Len(t, postorder, 5)
+	assert.Equal(t, 0, postorder[4])
+
+	tree := NewTree(CooperHarveyKennedy(predecessors, postorder), 0)
+
+	assert.Equal(t, []int{0, 0, 0, 0, 3}, tree.Idom)
+	assert.ElementsMatch(t, []int{1, 2, 3}, tree.Children[0])
+	assert.True(t, tree.Dominates(0, 4))
+	assert.True(t, tree.Dominates(3, 4))
+	assert.True(t, tree.Dominates(4, 4))
+	assert.False(t, tree.Dominates(1, 3))
+	assert.False(t, tree.Dominates(4, 3))
+}
+
+func TestPostorder(t *testing.T) {
+	successors := [][]int{{1}, {0}, {0}}
+
+	assert.Equal(t, []int{1, 0}, Postorder(successors, 0))
+}
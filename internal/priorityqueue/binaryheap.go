@@ -0,0 +1,101 @@
+package priorityqueue
+
+import "cmp"
+
+type binaryHeapEntry[T comparable, P cmp.Ordered] struct {
+	item     T
+	priority P
+}
+
+type binaryHeap[T comparable, P cmp.Ordered] struct {
+	entries []binaryHeapEntry[T, P]
+
+	// itemToIndex maps item to index of its entry in entries.
+	itemToIndex map[T]int
+}
+
+var _ PriorityQueue[struct{}, int] = (*binaryHeap[struct{}, int])(nil)
+
+// NewBinaryHeap creates an empty PriorityQueue using binary heap.
+//
+// https://en.wikipedia.org/wiki/Binary_heap
+func NewBinaryHeap[T comparable, P cmp.Ordered]() PriorityQueue[T, P] {
+	return &binaryHeap[T, P]{itemToIndex: make(map[T]int)}
+}
+
+func (heap *binaryHeap[T, P]) Push(item T, priority P) {
+	i, isPresent := heap.itemToIndex[item]
+
+	if !isPresent {
+		heap.entries = append(heap.entries, binaryHeapEntry[T, P]{item, priority})
+		i = len(heap.entries) - 1
+		heap.itemToIndex[item] = i
+	} else if priority < heap.entries[i].priority {
+		heap.entries[i].priority = priority
+	} else {
+		return
+	}
+
+	heap.siftUp(i)
+}
+
+func (heap *binaryHeap[T, P]) Pop() (T, P) {
+	top := heap.entries[0]
+	last := len(heap.entries) - 1
+
+	heap.swap(0, last)
+	heap.entries = heap.entries[:last]
+	delete(heap.itemToIndex, top.item)
+
+	if last > 0 {
+		heap.siftDown(0)
+	}
+
+	return top.item, top.priority
+}
+
+func (heap *binaryHeap[T, P]) Peek() (T, P) {
+	return heap.entries[0].item, heap.entries[0].priority
+}
+
+func (heap *binaryHeap[T, P]) IsEmpty() bool {
+	return len(heap.entries) == 0
+}
+
+func (heap *binaryHeap[T, P]) siftUp(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+
+		if heap.entries[parent].priority <= heap.entries[i].priority {
+			return
+		}
+
+		heap.swap(i, parent)
+		i = parent
+	}
+}
+
+func (heap *binaryHeap[T, P]) siftDown(i int) {
+	for {
+		least := i
+
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(heap.entries) && heap.entries[child].priority < heap.entries[least].priority {
+				least = child
+			}
+		}
+
+		if least == i {
+			return
+		}
+
+		heap.swap(i, least)
+		i = least
+	}
+}
+
+func (heap *binaryHeap[T, P]) swap(i, j int) {
+	heap.entries[i], heap.entries[j] = heap.entries[j], heap.entries[i]
+	heap.itemToIndex[heap.entries[i].item] = i
+	heap.itemToIndex[heap.entries[j].item] = j
+}
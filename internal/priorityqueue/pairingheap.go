@@ -0,0 +1,142 @@
+package priorityqueue
+
+import "cmp"
+
+type pairingHeapNode[T comparable, P cmp.Ordered] struct {
+	item     T
+	priority P
+
+	child *pairingHeapNode[T, P]
+	next  *pairingHeapNode[T, P]
+
+	// previous is the previous sibling or the parent of the first child.
+	previous *pairingHeapNode[T, P]
+}
+
+type pairingHeap[T comparable, P cmp.Ordered] struct {
+	root       *pairingHeapNode[T, P]
+	itemToNode map[T]*pairingHeapNode[T, P]
+}
+
+var _ PriorityQueue[struct{}, int] = (*pairingHeap[struct{}, int])(nil)
+
+// NewPairingHeap creates an empty PriorityQueue using pairing heap, which
+// has O(1) amortized Push and decrease-key.
+//
+// https://en.wikipedia.org/wiki/Pairing_heap
+func NewPairingHeap[T comparable, P cmp.Ordered]() PriorityQueue[T, P] {
+	return &pairingHeap[T, P]{itemToNode: make(map[T]*pairingHeapNode[T, P])}
+}
+
+func (heap *pairingHeap[T, P]) Push(item T, priority P) {
+	node, isPresent := heap.itemToNode[item]
+
+	if !isPresent {
+		node = &pairingHeapNode[T, P]{item: item, priority: priority}
+		heap.itemToNode[item] = node
+		heap.root = meld(heap.root, node)
+
+		return
+	}
+
+	if priority >= node.priority {
+		return
+	}
+
+	node.priority = priority
+
+	if node == heap.root {
+		return
+	}
+
+	// cut node with its subtree and meld it back
+	if node.previous.child == node {
+		node.previous.child = node.next
+	} else {
+		node.previous.next = node.next
+	}
+
+	if node.next != nil {
+		node.next.previous = node.previous
+	}
+
+	node.next = nil
+	node.previous = nil
+	heap.root = meld(heap.root, node)
+}
+
+func (heap *pairingHeap[T, P]) Pop() (T, P) {
+	root := heap.root
+
+	delete(heap.itemToNode, root.item)
+	heap.root = mergePairs(root.child)
+
+	if heap.root != nil {
+		heap.root.previous = nil
+	}
+
+	return root.item, root.priority
+}
+
+func (heap *pairingHeap[T, P]) Peek() (T, P) {
+	return heap.root.item, heap.root.priority
+}
+
+func (heap *pairingHeap[T, P]) IsEmpty() bool {
+	return heap.root == nil
+}
+
+// meld makes the root with greater priority the first child of the other one.
+func meld[T comparable, P cmp.Ordered](a, b *pairingHeapNode[T, P]) *pairingHeapNode[T, P] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if b.priority < a.priority {
+		a, b = b, a
+	}
+
+	b.previous = a
+	b.next = a.child
+
+	if a.child != nil {
+		a.child.previous = b
+	}
+
+	a.child = b
+
+	return a
+}
+
+// mergePairs melds siblings starting from first in pairs left to right and
+// then melds the pairs right to left (two-pass merge).
+func mergePairs[T comparable, P cmp.Ordered](first *pairingHeapNode[T, P]) *pairingHeapNode[T, P] {
+	pairs := make([]*pairingHeapNode[T, P], 0)
+
+	for first != nil {
+		a := first
+		b := a.next
+		first = nil
+
+		if b != nil {
+			first = b.next
+			b.next = nil
+			b.previous = nil
+		}
+
+		a.next = nil
+		a.previous = nil
+		pairs = append(pairs, meld(a, b))
+	}
+
+	var root *pairingHeapNode[T, P]
+
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = meld(pairs[i], root)
+	}
+
+	return root
+}
@@ -0,0 +1,24 @@
+// Package priorityqueue provides min priority queues with decrease-key
+// for graph algorithms.
+package priorityqueue
+
+import "cmp"
+
+// PriorityQueue is a min priority queue of distinct items.
+type PriorityQueue[T comparable, P cmp.Ordered] interface {
+	// Push inserts item with priority or, if item is already present,
+	// decreases its priority to priority if that is less.
+	Push(item T, priority P)
+
+	// Pop removes and returns an item with the least priority.
+	//
+	// It panics if this PriorityQueue is empty.
+	Pop() (T, P)
+
+	// Peek returns an item with the least priority without removing it.
+	//
+	// It panics if this PriorityQueue is empty.
+	Peek() (T, P)
+
+	IsEmpty() bool
+}
@@ -0,0 +1,64 @@
+package priorityqueue
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	for name, newPriorityQueue := range map[string]func() PriorityQueue[int, int]{
+		"binary heap":  NewBinaryHeap[int, int],
+		"pairing heap": NewPairingHeap[int, int],
+	} {
+		t.Run(name, func(t *testing.T) {
+			rng := rand.New(rand.NewPCG(1, 1))
+			priorityQueue := newPriorityQueue()
+			itemToPriority := make(map[int]int)
+
+			for range 1000 {
+				if rng.IntN(4) == 0 && !priorityQueue.IsEmpty() {
+					item, priority := priorityQueue.Pop()
+
+					assert.Equal(t, itemToPriority[item], priority)
+
+					for _, otherPriority := range itemToPriority {
+						assert.LessOrEqual(t, priority, otherPriority)
+					}
+
+					delete(itemToPriority, item)
+
+					continue
+				}
+
+				item := rng.IntN(200)
+				priority := rng.IntN(10_000)
+
+				priorityQueue.Push(item, priority)
+
+				if currentPriority, isPresent := itemToPriority[item]; !isPresent || priority < currentPriority {
+					itemToPriority[item] = priority
+				}
+			}
+
+			priorities := make([]int, 0)
+
+			for !priorityQueue.IsEmpty() {
+				peekedItem, peekedPriority := priorityQueue.Peek()
+				item, priority := priorityQueue.Pop()
+
+				assert.Equal(t, peekedItem, item)
+				assert.Equal(t, peekedPriority, priority)
+				assert.Equal(t, itemToPriority[item], priority)
+
+				delete(itemToPriority, item)
+				priorities = append(priorities, priority)
+			}
+
+			assert.Empty(t, itemToPriority)
+			assert.True(t, slices.IsSorted(priorities))
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package matching
+
+import (
+	"goraph/graph"
+	"slices"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+const (
+	unmatched = -1
+	infinity  = -1
+)
+
+// bipartiteGraph is a bipartite graph over indices of left and right
+// vertices.
+type bipartiteGraph[V graph.Vertex] struct {
+	left  []V
+	right []V
+
+	// adjacent maps left vertex to its right neighbors.
+	adjacent [][]int
+
+	mateOfLeft  []int
+	mateOfRight []int
+
+	// distance is the layer of left vertex in the current phase.
+	distance []int
+
+	// freeDistance is distance[u] + 1 of the nearest left vertex u adjacent
+	// to an unmatched right vertex, so it's the length of shortest
+	// augmenting paths in the current phase.
+	freeDistance int
+}
+
+func newBipartiteGraph[V graph.Vertex](left, right []V, edges []graph.Edge[V]) *bipartiteGraph[V] {
+	leftIndexer := graph.NewIndexer(slices.Values(left))
+	rightIndexer := graph.NewIndexer(slices.Values(right))
+
+	bipartite := &bipartiteGraph[V]{
+		left:        left,
+		right:       right,
+		adjacent:    make([][]int, len(left)),
+		mateOfLeft:  make([]int, len(left)),
+		mateOfRight: make([]int, len(right)),
+		distance:    make([]int, len(left)),
+	}
+
+	for _, edge := range edges {
+		u, _ := leftIndexer.Index(edge.Source())
+		v, _ := rightIndexer.Index(edge.Target())
+
+		bipartite.adjacent[u] = append(bipartite.adjacent[u], v)
+	}
+
+	for u := range bipartite.mateOfLeft {
+		bipartite.mateOfLeft[u] = unmatched
+	}
+
+	for v := range bipartite.mateOfRight {
+		bipartite.mateOfRight[v] = unmatched
+	}
+
+	return bipartite
+}
+
+// hopcroftKarp computes a maximum Matching: every phase finds layers of
+// alternating paths from unmatched left vertices by breadth-first search
+// and augments a maximal set of vertex-disjoint shortest paths along them
+// by depth-first search, there are O(sqrt(V)) phases.
+func (bipartite *bipartiteGraph[V]) hopcroftKarp() *Matching[V] {
+	nextNeighbor := make([]int, len(bipartite.left))
+
+	for bipartite.layer() {
+		for u := range nextNeighbor {
+			nextNeighbor[u] = 0
+		}
+
+		for u, mate := range bipartite.mateOfLeft {
+			if mate == unmatched {
+				bipartite.augment(u, nextNeighbor)
+			}
+		}
+	}
+
+	return bipartite.matching()
+}
+
+// layer computes distances of left vertices from unmatched left vertices
+// by alternating paths up to the nearest unmatched right vertex and returns
+// true iff some unmatched right vertex is reachable.
+func (bipartite *bipartiteGraph[V]) layer() bool {
+	queue := make([]int, 0, len(bipartite.left))
+
+	for u, mate := range bipartite.mateOfLeft {
+		if mate == unmatched {
+			bipartite.distance[u] = 0
+			queue = append(queue, u)
+		} else {
+			bipartite.distance[u] = infinity
+		}
+	}
+
+	bipartite.freeDistance = infinity
+
+	for head := 0; head < len(queue); head++ {
+		u := queue[head]
+
+		// layers beyond shortest augmenting paths are not needed
+		if bipartite.freeDistance != infinity && bipartite.distance[u] >= bipartite.freeDistance {
+			break
+		}
+
+		for _, v := range bipartite.adjacent[u] {
+			w := bipartite.mateOfRight[v]
+
+			switch {
+			case w == unmatched:
+				if bipartite.freeDistance == infinity {
+					bipartite.freeDistance = bipartite.distance[u] + 1
+				}
+
+			case bipartite.distance[w] == infinity:
+				bipartite.distance[w] = bipartite.distance[u] + 1
+				queue = append(queue, w)
+			}
+		}
+	}
+
+	return bipartite.freeDistance != infinity
+}
+
+// augment searches for an augmenting path from unmatched left vertex root
+// along the layers with an explicit stack and flips it if it's found.
+// Vertices without such paths are removed from the layers.
+func (bipartite *bipartiteGraph[V]) augment(root int, nextNeighbor []int) {
+	// via[i] is the right vertex between stack[i] and stack[i + 1]
+	stack := []int{root}
+	via := make([]int, 0)
+
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+
+		if nextNeighbor[u] == len(bipartite.adjacent[u]) {
+			bipartite.distance[u] = infinity
+			stack = stack[:len(stack)-1]
+
+			if len(via) > 0 {
+				via = via[:len(via)-1]
+			}
+
+			continue
+		}
+
+		v := bipartite.adjacent[u][nextNeighbor[u]]
+		nextNeighbor[u]++
+		w := bipartite.mateOfRight[v]
+
+		if w == unmatched {
+			// only shortest augmenting paths keep O(E * sqrt(V)) time
+			if bipartite.distance[u]+1 != bipartite.freeDistance {
+				continue
+			}
+
+			via = append(via, v)
+
+			for i, x := range stack {
+				bipartite.mateOfLeft[x] = via[i]
+				bipartite.mateOfRight[via[i]] = x
+			}
+
+			return
+		}
+
+		if bipartite.distance[w] == bipartite.distance[u]+1 {
+			stack = append(stack, w)
+			via = append(via, v)
+		}
+	}
+}
+
+// matching creates Matching with König's vertex cover: vertices reachable
+// by alternating paths from unmatched left vertices are found, the cover is
+// unreachable left vertices and reachable right vertices.
+func (bipartite *bipartiteGraph[V]) matching() *Matching[V] {
+	matching := &Matching[V]{
+		Edges:          make([]graph.Edge[V], 0),
+		Mate:           make(map[V]V),
+		VertexCover:    mapset.New[V](),
+		IndependentSet: mapset.New[V](),
+	}
+
+	for u, v := range bipartite.mateOfLeft {
+		if v != unmatched {
+			matching.Edges = append(matching.Edges, graph.NewEdge(bipartite.left[u], bipartite.right[v]))
+			matching.Mate[bipartite.left[u]] = bipartite.right[v]
+			matching.Mate[bipartite.right[v]] = bipartite.left[u]
+		}
+	}
+
+	isLeftReached := make([]bool, len(bipartite.left))
+	isRightReached := make([]bool, len(bipartite.right))
+	queue := make([]int, 0)
+
+	for u, v := range bipartite.mateOfLeft {
+		if v == unmatched {
+			isLeftReached[u] = true
+			queue = append(queue, u)
+		}
+	}
+
+	for head := 0; head < len(queue); head++ {
+		for _, v := range bipartite.adjacent[queue[head]] {
+			if isRightReached[v] {
+				continue
+			}
+
+			isRightReached[v] = true
+
+			// the matching is maximum, so v is matched
+			if w := bipartite.mateOfRight[v]; !isLeftReached[w] {
+				isLeftReached[w] = true
+				queue = append(queue, w)
+			}
+		}
+	}
+
+	for u, vertex := range bipartite.left {
+		if isLeftReached[u] {
+			matching.IndependentSet.Add(vertex)
+		} else {
+			matching.VertexCover.Add(vertex)
+		}
+	}
+
+	for v, vertex := range bipartite.right {
+		if isRightReached[v] {
+			matching.VertexCover.Add(vertex)
+		} else {
+			matching.IndependentSet.Add(vertex)
+		}
+	}
+
+	return matching
+}
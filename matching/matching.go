@@ -0,0 +1,126 @@
+// Package matching provides maximum matchings of bipartite graphs.
+//
+// https://en.wikipedia.org/wiki/Matching_(graph_theory)
+package matching
+
+import (
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set"
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// Matching is a maximum matching of a bipartite graph with its minimum
+// vertex cover and maximum independent set.
+type Matching[V graph.Vertex] struct {
+	// Edges holds matched edges from left to right.
+	Edges []graph.Edge[V]
+
+	// Mate maps every matched vertex of either side to its mate.
+	Mate map[V]V
+
+	// VertexCover is a minimum vertex cover: every edge has an endpoint
+	// in it. By König's theorem it has as many vertices as Edges.
+	//
+	// https://en.wikipedia.org/wiki/K%C5%91nig%27s_theorem_(graph_theory)
+	VertexCover set.Set[V]
+
+	// IndependentSet is a maximum independent set: no edge has both
+	// endpoints in it. It's the complement of VertexCover.
+	IndependentSet set.Set[V]
+}
+
+// Size returns the amount of matched edges.
+func (matching *Matching[V]) Size() int {
+	return len(matching.Edges)
+}
+
+// IsMatched returns true iff vertex is matched.
+func (matching *Matching[V]) IsMatched(vertex V) bool {
+	_, isMatched := matching.Mate[vertex]
+
+	return isMatched
+}
+
+// HopcroftKarp computes a maximum Matching of the bipartite graph with
+// vertices left and right and edges from left to right.
+//
+// It returns an error if left and right intersect or some edge doesn't go
+// from left to right.
+//
+// It panics if left, right or edges is nil.
+//
+// Time complexity: O(E sqrt(V)).
+//
+// https://en.wikipedia.org/wiki/Hopcroft%E2%80%93Karp_algorithm
+func HopcroftKarp[V graph.Vertex](
+	left set.Set[V],
+	right set.Set[V],
+	edges set.Set[graph.Edge[V]],
+) (*Matching[V], error) {
+	if left == nil {
+		panic("left == nil")
+	}
+	if right == nil {
+		panic("right == nil")
+	}
+	if edges == nil {
+		panic("edges == nil")
+	}
+
+	leftVertices := left.Elements()
+	rightVertices := right.Elements()
+
+	for _, vertex := range leftVertices {
+		if right.Contains(vertex) {
+			return nil, fmt.Errorf("vertex %v is in both left and right", vertex)
+		}
+	}
+
+	for _, edge := range edges.Elements() {
+		if !left.Contains(edge.Source()) || !right.Contains(edge.Target()) {
+			return nil, fmt.Errorf("edge %+v doesn't go from left to right", edge)
+		}
+	}
+
+	return newBipartiteGraph(leftVertices, rightVertices, edges.Elements()).hopcroftKarp(), nil
+}
+
+// HopcroftKarpSimpleDigraph computes a maximum Matching of the bipartite
+// graph given by simpleDigraph with every edge from left to right: sources
+// of edges are left, targets of edges are right and isolated vertices
+// are left.
+//
+// It returns an error if some vertex is both a source and a target.
+//
+// It panics if simpleDigraph is nil.
+//
+// Time complexity: O(E sqrt(V)).
+func HopcroftKarpSimpleDigraph[V graph.Vertex](simpleDigraph simpledigraph.SimpleDigraph[V]) (*Matching[V], error) {
+	if simpleDigraph == nil {
+		panic("simpleDigraph == nil")
+	}
+
+	left := mapset.New[V]()
+	right := mapset.New[V]()
+
+	for vertex := range simpleDigraph.AllVertices() {
+		isSource := simpleDigraph.OutDegree(vertex) > 0
+		isTarget := simpleDigraph.InDegree(vertex) > 0
+
+		switch {
+		case isSource && isTarget:
+			return nil, fmt.Errorf("vertex %v is both a source and a target of edges", vertex)
+
+		case isTarget:
+			right.Add(vertex)
+
+		default:
+			left.Add(vertex)
+		}
+	}
+
+	return HopcroftKarp(left, right, simpleDigraph.Edges())
+}
@@ -0,0 +1,182 @@
+package matching
+
+import (
+	"goraph/graph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/graph/generate"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set"
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+// kuhnSize returns the size of a maximum matching found by simple
+// augmenting paths.
+func kuhnSize(left []int, edges []graph.Edge[int]) int {
+	adjacent := make(map[int][]int)
+	mate := make(map[int]int)
+
+	for _, edge := range edges {
+		adjacent[edge.Source()] = append(adjacent[edge.Source()], edge.Target())
+	}
+
+	var tryAugment func(u int, isVisited map[int]bool) bool
+
+	tryAugment = func(u int, isVisited map[int]bool) bool {
+		for _, v := range adjacent[u] {
+			if isVisited[v] {
+				continue
+			}
+
+			isVisited[v] = true
+
+			if w, isMatched := mate[v]; !isMatched || tryAugment(w, isVisited) {
+				mate[v] = u
+
+				return true
+			}
+		}
+
+		return false
+	}
+
+	size := 0
+
+	for _, u := range left {
+		if tryAugment(u, make(map[int]bool)) {
+			size++
+		}
+	}
+
+	return size
+}
+
+func assertIsMaximumMatching(
+	t *testing.T,
+	left, right set.Set[int],
+	edges set.Set[graph.Edge[int]],
+	matching *Matching[int],
+) {
+	assert.Equal(t, kuhnSize(left.Elements(), edges.Elements()), matching.Size())
+	assert.Len(t, matching.Mate, 2*matching.Size())
+
+	for _, edge := range matching.Edges {
+		assert.True(t, edges.Contains(edge))
+		assert.Equal(t, edge.Target(), matching.Mate[edge.Source()])
+		assert.Equal(t, edge.Source(), matching.Mate[edge.Target()])
+	}
+
+	assert.Equal(t, matching.Size(), matching.VertexCover.Size())
+	assert.Equal(t, left.Size()+right.Size(), matching.VertexCover.Size()+matching.IndependentSet.Size())
+
+	for _, edge := range edges.Elements() {
+		assert.True(t, matching.VertexCover.Contains(edge.Source()) || matching.VertexCover.Contains(edge.Target()))
+		assert.False(t, matching.IndependentSet.Contains(edge.Source()) && matching.IndependentSet.Contains(edge.Target()))
+	}
+}
+
+func TestHopcroftKarp(t *testing.T) {
+	left := mapset.NewFromElements(1, 2, 3, 4)
+	right := mapset.NewFromElements(5, 6, 7)
+	edges := mapset.NewFromElements(
+		graph.NewEdge(1, 5),
+		graph.NewEdge(1, 6),
+		graph.NewEdge(2, 5),
+		graph.NewEdge(3, 5),
+		graph.NewEdge(3, 7),
+	)
+
+	matching, err := HopcroftKarp(left, right, edges)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, matching.Size())
+	assert.True(t, matching.IsMatched(7))
+	assert.False(t, matching.IsMatched(4))
+	assertIsMaximumMatching(t, left, right, edges, matching)
+	assert.True(t, matching.IndependentSet.Contains(4))
+
+	_, err = HopcroftKarp(left, mapset.NewFromElements(4, 5), mapset.New[graph.Edge[int]]())
+
+	assert.NotNil(t, err)
+
+	_, err = HopcroftKarp(left, right, mapset.NewFromElements(graph.NewEdge(5, 1)))
+
+	assert.NotNil(t, err)
+	assert.Panics(t, func() { _, _ = HopcroftKarp(nil, right, edges) })
+}
+
+func TestHopcroftKarpSimpleDigraph(t *testing.T) {
+	simpleDigraph, err := generate.CompleteBipartite(3, 5)
+
+	assert.Nil(t, err)
+
+	matching, err := HopcroftKarpSimpleDigraph(simpleDigraph)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, matching.Size())
+	assert.Equal(t, 5, matching.IndependentSet.Size())
+
+	path, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3),
+		mapset.NewFromElements(graph.NewEdge(1, 2), graph.NewEdge(2, 3)),
+	)
+
+	_, err = HopcroftKarpSimpleDigraph(path)
+
+	assert.NotNil(t, err)
+}
+
+func TestHopcroftKarp_ShortestAugmentingPaths(t *testing.T) {
+	// 1 is unmatched and adjacent to unmatched 14, 2 is matched to 11 and
+	// adjacent to unmatched 15, so 1 - 11 - 2 - 15 is longer than 1 - 14
+	bipartite := newBipartiteGraph(
+		[]int{1, 2, 3},
+		[]int{11, 12, 13, 14, 15},
+		[]graph.Edge[int]{
+			graph.NewEdge(1, 11),
+			graph.NewEdge(1, 14),
+			graph.NewEdge(2, 11),
+			graph.NewEdge(2, 12),
+			graph.NewEdge(2, 15),
+			graph.NewEdge(3, 12),
+		},
+	)
+	bipartite.mateOfLeft[1], bipartite.mateOfRight[0] = 0, 1
+	bipartite.mateOfLeft[2], bipartite.mateOfRight[1] = 1, 2
+
+	assert.True(t, bipartite.layer())
+	assert.Equal(t, 1, bipartite.freeDistance)
+	assert.Equal(t, infinity, bipartite.distance[2])
+
+	bipartite.augment(0, make([]int, 3))
+
+	assert.Equal(t, []int{3, 0, 1}, bipartite.mateOfLeft)
+}
+
+func TestHopcroftKarp_Random(t *testing.T) {
+	rng := generate.NewRand(1)
+
+	for i := range 100 {
+		simpleDigraph, err := generate.RandomBipartite(10+i%7, 12-i%5, 0.05+float64(i%4)*0.05, rng)
+
+		assert.Nil(t, err)
+
+		left := mapset.New[int]()
+		right := mapset.New[int]()
+
+		matching, err := HopcroftKarpSimpleDigraph(simpleDigraph)
+
+		assert.Nil(t, err)
+
+		for vertex := range simpleDigraph.AllVertices() {
+			if simpleDigraph.InDegree(vertex) > 0 {
+				right.Add(vertex)
+			} else {
+				left.Add(vertex)
+			}
+		}
+
+		assertIsMaximumMatching(t, left, right, simpleDigraph.Edges(), matching)
+	}
+}
@@ -0,0 +1,137 @@
+package dimacs
+
+import (
+	"bytes"
+	"goraph/graph"
+	mf "goraph/maxflow"
+	"goraph/maxflow/edmondskarp"
+	"strings"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// the same network as in edmondskarp tests with A..G labeled from 1 to 7
+const maxFlowInstance = `c https://en.wikipedia.org/wiki/Edmonds-Karp_algorithm#Example
+p max 7 11
+n 1 s
+n 7 t
+a 1 2 3
+a 1 4 3
+a 2 3 4
+a 3 1 3
+a 3 4 1
+a 3 5 2
+a 4 5 2
+a 4 6 6
+a 5 2 1
+a 5 7 1
+a 6 7 9
+`
+
+func TestReadMaxFlow(t *testing.T) {
+	network, err := ReadMaxFlow(strings.NewReader(maxFlowInstance))
+
+	assert.Nil(t, err)
+	assert.NotNil(t, network)
+
+	assert.Equal(t, mapset.NewFromElements(1, 2, 3, 4, 5, 6, 7), network.Vertices())
+	assert.Equal(t, 11, network.Size())
+	assert.Equal(t, 1, network.S)
+	assert.Equal(t, 7, network.T)
+	assert.Equal(t, uint32(6), network.Capacity[graph.NewEdge(4, 6)])
+	assert.Equal(t, uint32(0), network.Flow[graph.NewEdge(4, 6)])
+	assert.Equal(t, 11, len(network.Flow))
+
+	maxFlow, err := edmondskarp.NewEdmondsKarp[int]().Compute(network)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), maxFlow[graph.NewEdge(5, 7)])
+	assert.Equal(t, uint32(4), maxFlow[graph.NewEdge(6, 7)])
+}
+
+func TestReadMaxFlow_ParallelArcs(t *testing.T) {
+	network, err := ReadMaxFlow(strings.NewReader("p max 2 2\nn 1 s\nn 2 t\na 1 2 3\na 1 2 4\n"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, mf.Capacity[int]{graph.NewEdge(1, 2): 7}, network.Capacity)
+}
+
+func TestReadMaxFlow_Errors(t *testing.T) {
+	inputs := []string{
+		"",
+		"p min 2 1\nn 1 s\nn 2 t\na 1 2 3\n",
+		"p max 2 1\nn 1 s\na 1 2 3\n",
+		"p max 2 1\nn 1 s\nn 2 t\na 1 1 3\n",
+		"p max 2 1\nn 1 s\nn 2 t\na 1 3 3\n",
+		"p max 2 1\nn 1 s\nn 2 t\na 1 2 -3\n",
+		"p max 2 2\nn 1 s\nn 2 t\na 1 2 3\n",
+	}
+
+	for _, input := range inputs {
+		network, err := ReadMaxFlow(strings.NewReader(input))
+
+		assert.Nil(t, network)
+		assert.NotNil(t, err, input)
+	}
+}
+
+func TestWriteMaxFlow(t *testing.T) {
+	network, _ := ReadMaxFlow(strings.NewReader(maxFlowInstance))
+
+	var buffer bytes.Buffer
+
+	assert.Nil(t, WriteMaxFlow(&buffer, network))
+
+	expected := maxFlowInstance[strings.Index(maxFlowInstance, "\n")+1:]
+
+	assert.Equal(t, expected, buffer.String())
+}
+
+const minCostFlowInstance = `p min 4 5
+n 1 4
+n 4 -4
+a 1 2 0 4 2
+a 1 3 0 2 2
+a 2 3 0 2 1
+a 2 4 1 3 3
+a 3 4 0 5 1
+`
+
+func TestReadMinCostFlow(t *testing.T) {
+	network, err := ReadMinCostFlow(strings.NewReader("c comment\n" + minCostFlowInstance))
+
+	assert.Nil(t, err)
+	assert.NotNil(t, network)
+
+	assert.Equal(t, 4, network.Order())
+	assert.Equal(t, 5, network.Size())
+	assert.Equal(t, map[int]int64{1: 4, 4: -4}, network.Supply)
+	assert.Equal(t, uint32(1), network.LowerBound[graph.NewEdge(2, 4)])
+	assert.Equal(t, uint32(3), network.Capacity[graph.NewEdge(2, 4)])
+	assert.Equal(t, int64(3), network.Cost[graph.NewEdge(2, 4)])
+	assert.Equal(t, 5, len(network.Flow))
+
+	var buffer bytes.Buffer
+
+	assert.Nil(t, WriteMinCostFlow(&buffer, network))
+	assert.Equal(t, minCostFlowInstance, buffer.String())
+}
+
+func TestReadMinCostFlow_Errors(t *testing.T) {
+	inputs := []string{
+		"p max 2 1\na 1 2 0 1 1\n",
+		"p min 2 2\na 1 2 0 1 1\na 1 2 0 1 1\n",
+		"p min 2 1\na 1 2 2 1 1\n",
+		"p min 2 1\na 1 2 0 1\n",
+	}
+
+	for _, input := range inputs {
+		network, err := ReadMinCostFlow(strings.NewReader(input))
+
+		assert.Nil(t, network)
+		assert.NotNil(t, err, input)
+	}
+}
@@ -0,0 +1,189 @@
+package dimacs
+
+import (
+	"bufio"
+	"cmp"
+	"errors"
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set"
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// lineReader reads non-empty non-comment DIMACS lines split into fields.
+type lineReader struct {
+	scanner    *bufio.Scanner
+	lineNumber int
+	fields     []string
+}
+
+func newLineReader(r io.Reader) *lineReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	return &lineReader{scanner: scanner}
+}
+
+// next advances to the next line with at least one field that is not a comment.
+func (reader *lineReader) next() bool {
+	for reader.scanner.Scan() {
+		reader.lineNumber++
+		reader.fields = strings.Fields(reader.scanner.Text())
+
+		if len(reader.fields) > 0 && reader.fields[0] != "c" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (reader *lineReader) err() error {
+	return reader.scanner.Err()
+}
+
+// readProblemLine reads 'p <problemType> <n> <m>' line.
+func (reader *lineReader) readProblemLine(problemType string) (int, int, error) {
+	if !reader.next() {
+		if err := reader.err(); err != nil {
+			return 0, 0, err
+		}
+
+		return 0, 0, errors.New("problem line is missing")
+	}
+
+	if len(reader.fields) != 4 || reader.fields[0] != "p" || reader.fields[1] != problemType {
+		return 0, 0, reader.errorf("expected 'p %s <n> <m>'", problemType)
+	}
+
+	amountOfVertices, err := reader.parseNonNegativeInt(reader.fields[2])
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	amountOfArcs, err := reader.parseNonNegativeInt(reader.fields[3])
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return amountOfVertices, amountOfArcs, nil
+}
+
+// readMaxFlowNodeLine reads 'n <vertex> <s|t>' line.
+func (reader *lineReader) readMaxFlowNodeLine(amountOfVertices int) (int, string, error) {
+	if len(reader.fields) != 3 {
+		return 0, "", reader.errorf("expected 'n <vertex> <s|t>'")
+	}
+
+	vertex, err := reader.parseVertex(reader.fields[1], amountOfVertices)
+
+	if err != nil {
+		return 0, "", err
+	}
+
+	return vertex, reader.fields[2], nil
+}
+
+// readArc reads '<u> <v>' fields of 'a' line.
+func (reader *lineReader) readArc(amountOfVertices int) (graph.Edge[int], error) {
+	u, err := reader.parseVertex(reader.fields[1], amountOfVertices)
+
+	if err != nil {
+		return graph.Edge[int]{}, err
+	}
+
+	v, err := reader.parseVertex(reader.fields[2], amountOfVertices)
+
+	if err != nil {
+		return graph.Edge[int]{}, err
+	}
+
+	if u == v {
+		return graph.Edge[int]{}, reader.errorf("loop arcs are not allowed")
+	}
+
+	return graph.NewEdge(u, v), nil
+}
+
+func (reader *lineReader) parseVertex(field string, amountOfVertices int) (int, error) {
+	vertex, err := strconv.Atoi(field)
+
+	if err != nil || vertex < 1 || vertex > amountOfVertices {
+		return 0, reader.errorf("vertex %q is not in [1, %d]", field, amountOfVertices)
+	}
+
+	return vertex, nil
+}
+
+func (reader *lineReader) parseNonNegativeInt(field string) (int, error) {
+	value, err := strconv.Atoi(field)
+
+	if err != nil || value < 0 {
+		return 0, reader.errorf("%q is not a non-negative integer", field)
+	}
+
+	return value, nil
+}
+
+func (reader *lineReader) parseUint32(field string) (uint32, error) {
+	value, err := strconv.ParseUint(field, 10, 32)
+
+	if err != nil {
+		return 0, reader.errorf("%q is not a uint32", field)
+	}
+
+	return uint32(value), nil
+}
+
+func (reader *lineReader) parseInt64(field string) (int64, error) {
+	value, err := strconv.ParseInt(field, 10, 64)
+
+	if err != nil {
+		return 0, reader.errorf("%q is not an int64", field)
+	}
+
+	return value, nil
+}
+
+func (reader *lineReader) errorf(format string, arguments ...any) error {
+	return fmt.Errorf("line %d: %s", reader.lineNumber, fmt.Sprintf(format, arguments...))
+}
+
+// newVertices returns a set.Set of vertices from 1 to amountOfVertices.
+func newVertices(amountOfVertices int) set.Set[int] {
+	vertices := mapset.New[int]()
+
+	for i := 1; i <= amountOfVertices; i++ {
+		vertices.Add(i)
+	}
+
+	return vertices
+}
+
+func assertVerticesAreLabeledFromOne(digraph digraph.Digraph[int]) error {
+	for vertex := range digraph.AllVertices() {
+		if vertex < 1 || vertex > digraph.Order() {
+			return fmt.Errorf("vertex %d is not in [1, %d]", vertex, digraph.Order())
+		}
+	}
+
+	return nil
+}
+
+func sortedEdges(digraph digraph.Digraph[int]) []graph.Edge[int] {
+	edges := slices.Collect(digraph.AllEdges())
+
+	slices.SortFunc(edges, func(uv, xy graph.Edge[int]) int {
+		return cmp.Or(cmp.Compare(uv.Source(), xy.Source()), cmp.Compare(uv.Target(), xy.Target()))
+	})
+
+	return edges
+}
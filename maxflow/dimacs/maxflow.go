@@ -0,0 +1,157 @@
+package dimacs
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"goraph/graph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	mf "goraph/maxflow"
+	"io"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// ReadMaxFlow reads a max flow problem in DIMACS format from r and creates
+// mf.SimpleFlowNetwork with vertices labeled from 1 to n and zeroed Flow.
+//
+// Parallel arcs are merged into one edge with the sum of their capacities,
+// which doesn't change the max flow value. Loops are not allowed.
+//
+// http://lpsolve.sourceforge.net/5.5/DIMACS_maxf.htm
+func ReadMaxFlow(r io.Reader) (*mf.SimpleFlowNetwork[int], error) {
+	if r == nil {
+		return nil, errors.New("r == nil")
+	}
+
+	reader := newLineReader(r)
+
+	amountOfVertices, amountOfArcs, err := reader.readProblemLine("max")
+
+	if err != nil {
+		return nil, err
+	}
+
+	var s, t int
+	sIsPresent, tIsPresent := false, false
+	capacity := make(mf.Capacity[int], amountOfArcs)
+	readArcs := 0
+
+	for reader.next() {
+		switch reader.fields[0] {
+		case "n":
+			vertex, designator, err := reader.readMaxFlowNodeLine(amountOfVertices)
+
+			if err != nil {
+				return nil, err
+			}
+
+			switch {
+			case designator == "s" && !sIsPresent:
+				s, sIsPresent = vertex, true
+			case designator == "t" && !tIsPresent:
+				t, tIsPresent = vertex, true
+			default:
+				return nil, reader.errorf("unexpected node designator %q", designator)
+			}
+
+		case "a":
+			if len(reader.fields) != 4 {
+				return nil, reader.errorf("expected 'a <u> <v> <capacity>'")
+			}
+
+			uv, err := reader.readArc(amountOfVertices)
+
+			if err != nil {
+				return nil, err
+			}
+
+			uvCapacity, err := reader.parseUint32(reader.fields[3])
+
+			if err != nil {
+				return nil, err
+			}
+
+			if uint64(capacity[uv])+uint64(uvCapacity) > uint64(^uint32(0)) {
+				return nil, reader.errorf("total capacity of parallel arcs overflows uint32")
+			}
+
+			capacity[uv] += uvCapacity
+			readArcs++
+
+		default:
+			return nil, reader.errorf("unexpected line type %q", reader.fields[0])
+		}
+	}
+
+	if err := reader.err(); err != nil {
+		return nil, err
+	}
+
+	if !sIsPresent || !tIsPresent {
+		return nil, errors.New("source or sink node line is missing")
+	}
+	if s == t {
+		return nil, errors.New("source == sink")
+	}
+	if readArcs != amountOfArcs {
+		return nil, fmt.Errorf("expected %d arcs, got %d", amountOfArcs, readArcs)
+	}
+
+	edges := mapset.New[graph.Edge[int]]()
+	flow := make(mf.Flow[int], len(capacity))
+
+	for edge := range capacity {
+		edges.Add(edge)
+		flow[edge] = 0
+	}
+
+	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(newVertices(amountOfVertices), edges)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mf.SimpleFlowNetwork[int]{
+		SimpleDigraph: simpleDigraph,
+		S:             s,
+		T:             t,
+		Capacity:      capacity,
+		Flow:          flow,
+	}, nil
+}
+
+// WriteMaxFlow writes network as a max flow problem in DIMACS format to w.
+//
+// Vertices of network must be labeled from 1 to n. network.Flow is not written.
+// Arcs are sorted by source and target, so the output is deterministic.
+func WriteMaxFlow(w io.Writer, network *mf.SimpleFlowNetwork[int]) error {
+	if w == nil {
+		return errors.New("w == nil")
+	}
+	if network == nil {
+		return errors.New("network == nil")
+	}
+	if network.SimpleDigraph == nil {
+		return errors.New("network.SimpleDigraph == nil")
+	}
+	if network.Capacity == nil {
+		return errors.New("network.Capacity == nil")
+	}
+
+	if err := assertVerticesAreLabeledFromOne(network.SimpleDigraph); err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(w)
+
+	fmt.Fprintf(writer, "p max %d %d\n", network.Order(), network.Size())
+	fmt.Fprintf(writer, "n %d s\n", network.S)
+	fmt.Fprintf(writer, "n %d t\n", network.T)
+
+	for _, edge := range sortedEdges(network.SimpleDigraph) {
+		fmt.Fprintf(writer, "a %d %d %d\n", edge.Source(), edge.Target(), network.Capacity[edge])
+	}
+
+	return writer.Flush()
+}
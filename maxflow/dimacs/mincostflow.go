@@ -0,0 +1,207 @@
+package dimacs
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	mf "goraph/maxflow"
+	"io"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// MinCostFlowNetwork represents a min cost flow problem.
+//
+// https://en.wikipedia.org/wiki/Minimum-cost_flow_problem
+type MinCostFlowNetwork struct {
+	simpledigraph.SimpleDigraph[int]
+
+	// Supply maps vertex to its supply (if positive) or demand (if negative).
+	// Vertices without a mapping have zero supply.
+	Supply map[int]int64
+
+	// LowerBound must have a mapping for every edge in simpledigraph.SimpleDigraph.
+	LowerBound mf.Capacity[int]
+
+	// Capacity must have a mapping for every edge in simpledigraph.SimpleDigraph.
+	Capacity mf.Capacity[int]
+
+	// Cost must have a mapping for every edge in simpledigraph.SimpleDigraph.
+	Cost map[graph.Edge[int]]int64
+
+	// Flow must have a mapping for every edge in simpledigraph.SimpleDigraph.
+	Flow mf.Flow[int]
+}
+
+// ReadMinCostFlow reads a min cost flow problem in DIMACS format from r and
+// creates MinCostFlowNetwork with vertices labeled from 1 to n and zeroed Flow.
+//
+// Parallel arcs and loops are not allowed.
+//
+// http://lpsolve.sourceforge.net/5.5/DIMACS_mcf.htm
+func ReadMinCostFlow(r io.Reader) (*MinCostFlowNetwork, error) {
+	if r == nil {
+		return nil, errors.New("r == nil")
+	}
+
+	reader := newLineReader(r)
+
+	amountOfVertices, amountOfArcs, err := reader.readProblemLine("min")
+
+	if err != nil {
+		return nil, err
+	}
+
+	supply := make(map[int]int64)
+	edges := mapset.New[graph.Edge[int]]()
+	lowerBound := make(mf.Capacity[int], amountOfArcs)
+	capacity := make(mf.Capacity[int], amountOfArcs)
+	cost := make(map[graph.Edge[int]]int64, amountOfArcs)
+	flow := make(mf.Flow[int], amountOfArcs)
+
+	for reader.next() {
+		switch reader.fields[0] {
+		case "n":
+			if len(reader.fields) != 3 {
+				return nil, reader.errorf("expected 'n <vertex> <supply>'")
+			}
+
+			vertex, err := reader.parseVertex(reader.fields[1], amountOfVertices)
+
+			if err != nil {
+				return nil, err
+			}
+
+			vertexSupply, err := reader.parseInt64(reader.fields[2])
+
+			if err != nil {
+				return nil, err
+			}
+
+			supply[vertex] = vertexSupply
+
+		case "a":
+			if len(reader.fields) != 6 {
+				return nil, reader.errorf("expected 'a <u> <v> <lower bound> <capacity> <cost>'")
+			}
+
+			uv, err := reader.readArc(amountOfVertices)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if edges.Contains(uv) {
+				return nil, reader.errorf("parallel arcs are not allowed")
+			}
+
+			uvLowerBound, err := reader.parseUint32(reader.fields[3])
+
+			if err != nil {
+				return nil, err
+			}
+
+			uvCapacity, err := reader.parseUint32(reader.fields[4])
+
+			if err != nil {
+				return nil, err
+			}
+
+			if uvLowerBound > uvCapacity {
+				return nil, reader.errorf("lower bound > capacity")
+			}
+
+			uvCost, err := reader.parseInt64(reader.fields[5])
+
+			if err != nil {
+				return nil, err
+			}
+
+			edges.Add(uv)
+			lowerBound[uv] = uvLowerBound
+			capacity[uv] = uvCapacity
+			cost[uv] = uvCost
+			flow[uv] = 0
+
+		default:
+			return nil, reader.errorf("unexpected line type %q", reader.fields[0])
+		}
+	}
+
+	if err := reader.err(); err != nil {
+		return nil, err
+	}
+
+	if edges.Size() != amountOfArcs {
+		return nil, fmt.Errorf("expected %d arcs, got %d", amountOfArcs, edges.Size())
+	}
+
+	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(newVertices(amountOfVertices), edges)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &MinCostFlowNetwork{
+		SimpleDigraph: simpleDigraph,
+		Supply:        supply,
+		LowerBound:    lowerBound,
+		Capacity:      capacity,
+		Cost:          cost,
+		Flow:          flow,
+	}, nil
+}
+
+// WriteMinCostFlow writes network as a min cost flow problem in DIMACS format to w.
+//
+// Vertices of network must be labeled from 1 to n. network.Flow is not written.
+// Vertices with zero supply are omitted. Node and arc lines are sorted,
+// so the output is deterministic.
+func WriteMinCostFlow(w io.Writer, network *MinCostFlowNetwork) error {
+	if w == nil {
+		return errors.New("w == nil")
+	}
+	if network == nil {
+		return errors.New("network == nil")
+	}
+	if network.SimpleDigraph == nil {
+		return errors.New("network.SimpleDigraph == nil")
+	}
+	if network.Capacity == nil {
+		return errors.New("network.Capacity == nil")
+	}
+	if network.Cost == nil {
+		return errors.New("network.Cost == nil")
+	}
+
+	if err := assertVerticesAreLabeledFromOne(network.SimpleDigraph); err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(w)
+
+	fmt.Fprintf(writer, "p min %d %d\n", network.Order(), network.Size())
+
+	for vertex := 1; vertex <= network.Order(); vertex++ {
+		if vertexSupply := network.Supply[vertex]; vertexSupply != 0 {
+			fmt.Fprintf(writer, "n %d %d\n", vertex, vertexSupply)
+		}
+	}
+
+	for _, edge := range sortedEdges(network.SimpleDigraph) {
+		fmt.Fprintf(
+			writer,
+			"a %d %d %d %d %d\n",
+			edge.Source(),
+			edge.Target(),
+			network.LowerBound[edge],
+			network.Capacity[edge],
+			network.Cost[edge],
+		)
+	}
+
+	return writer.Flush()
+}
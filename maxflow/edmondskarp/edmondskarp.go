@@ -186,7 +186,7 @@ func breadthFirstSearch[V graph.Vertex](
 	for !vertexQueue.IsEmpty() {
 		u := vertexQueue.Pop()
 
-		for _, v := range digraph.Successors(u).Elements() {
+		for v := range digraph.SuccessorsSeq(u) {
 			if !visitedVertices.Contains(v) {
 				vertexToPredecessor[v] = u
 
@@ -3,15 +3,19 @@ package edmondskarp
 import (
 	"fmt"
 	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
 	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/graph/generate"
 	mf "goraph/maxflow"
-	"math/rand"
+	"math"
 	"testing"
 
-	"github.com/nikolai-kramskoy/go-data-structures/set"
 	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
 )
 
+// benchmarkSeed makes generated networks and so benchmark results reproducible.
+const benchmarkSeed = 42
+
 func BenchmarkEdmondsKarp_Compute_1(b *testing.B) {
 	amountOfVertices := 10
 	amountOfEdges := 50
@@ -54,6 +58,21 @@ func BenchmarkEdmondsKarp_Compute_3(b *testing.B) {
 	edmondsKarp_Compute_Benchmark(b, generateCompleteSimpleFlowNetwork(amountOfVertices))
 }
 
+func BenchmarkEdmondsKarp_Compute_4(b *testing.B) {
+	amountOfVertices := 100
+	amountOfEdges := amountOfVertices * amountOfVertices
+
+	fmt.Printf(
+		"amountOfVertices = %d, amountOfEdges = %d, graphIsComplete = %t, csr = %t ",
+		amountOfVertices,
+		amountOfEdges,
+		true,
+		true,
+	)
+
+	edmondsKarp_Compute_Benchmark(b, generateCompleteCSRSimpleFlowNetwork(amountOfVertices))
+}
+
 func edmondsKarp_Compute_Benchmark(b *testing.B, network *mf.SimpleFlowNetwork[int]) {
 	edmondsKarp := NewEdmondsKarp[int]()
 
@@ -96,131 +115,75 @@ func generateSimpleFlowNetwork(
 	amountOfVertices int,
 	amountOfEdges int,
 ) *mf.SimpleFlowNetwork[int] {
-	edges, capacity, flow := newEdgesCapacityFlow(amountOfVertices, amountOfEdges)
-
-	return generateSimpleFlowNetworkHelper(amountOfVertices, edges, capacity, flow)
-}
-
-// Vertices are labeled from 1 to amountOfVertices.
-func generateCompleteSimpleFlowNetwork(
-	amountOfVertices int,
-) *mf.SimpleFlowNetwork[int] {
-	edges, capacity, flow := newEdgesCapacityFlowCompleteGraph(amountOfVertices)
-
-	return generateSimpleFlowNetworkHelper(amountOfVertices, edges, capacity, flow)
-}
-
-func generateSimpleFlowNetworkHelper(
-	amountOfVertices int,
-	edges set.Set[graph.Edge[int]],
-	capacity mf.Capacity[int],
-	flow mf.Flow[int],
-) *mf.SimpleFlowNetwork[int] {
-	vertices := newVertices(amountOfVertices)
-
-	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+	network, err := generate.RandomFlowNetwork(
+		amountOfVertices,
+		amountOfEdges,
+		math.MaxInt32,
+		generate.NewRand(benchmarkSeed),
+	)
 
 	if err != nil {
 		panic(err)
 	}
 
-	s := rand.Intn(amountOfVertices) + 1
-	t := rand.Intn(amountOfVertices) + 1
-
-	for s == t {
-		t = rand.Intn(amountOfVertices) + 1
-	}
-
-	return &mf.SimpleFlowNetwork[int]{
-		SimpleDigraph: simpleDigraph,
-		S:             s,
-		T:             t,
-		Capacity:      capacity,
-		Flow:          flow,
-	}
+	return network
 }
 
-func newVertices(amountOfVertices int) set.Set[int] {
+// Vertices are labeled from 1 to amountOfVertices, the network is backed
+// by adjacency list.
+func generateCompleteSimpleFlowNetwork(
+	amountOfVertices int,
+) *mf.SimpleFlowNetwork[int] {
 	vertices := mapset.New[int]()
-
-	for i := 1; i <= amountOfVertices; i++ {
-		vertices.Add(i)
-	}
-
-	return vertices
-}
-
-func newEdgesCapacityFlowCompleteGraph(amountOfVertices int) (
-	set.Set[graph.Edge[int]],
-	mf.Capacity[int],
-	mf.Flow[int],
-) {
-	amountOfEdges := amountOfVertices * (amountOfVertices - 1)
-
-	edgesSliceIndex := 0
-	edgesSlice := make([]graph.Edge[int], amountOfEdges)
-	capacity := make(mf.Capacity[int], amountOfEdges)
-	flow := make(mf.Flow[int], amountOfEdges)
+	edges := mapset.New[graph.Edge[int]]()
 
 	for u := 1; u <= amountOfVertices; u++ {
+		vertices.Add(u)
+
 		for v := 1; v <= amountOfVertices; v++ {
-			if u == v {
-				continue
+			if u != v {
+				edges.Add(graph.NewEdge(u, v))
 			}
+		}
+	}
 
-			uv := graph.NewEdge(u, v)
-			uvCapacity, uvFlow := randCapacityAndFlowValues()
+	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(vertices, edges)
 
-			edgesSlice[edgesSliceIndex] = uv
-			edgesSliceIndex++
-			capacity[uv] = uvCapacity
-			flow[uv] = uvFlow
-		}
+	if err != nil {
+		panic(err)
 	}
 
-	return mapset.NewFromElements(edgesSlice...), capacity, flow
+	return newCompleteSimpleFlowNetwork(simpleDigraph)
 }
 
-func newEdgesCapacityFlow(
+// Vertices are labeled from 1 to amountOfVertices, the network is backed
+// by CSR.
+func generateCompleteCSRSimpleFlowNetwork(
 	amountOfVertices int,
-	amountOfEdges int,
-) (set.Set[graph.Edge[int]], mf.Capacity[int], mf.Flow[int]) {
-	edgesSliceIndex := 0
-	edgesSlice := make([]graph.Edge[int], amountOfEdges)
-	capacity := make(mf.Capacity[int], amountOfEdges)
-	flow := make(mf.Flow[int], amountOfEdges)
-
-	for i := 1; i <= amountOfEdges; i++ {
-		u := rand.Intn(amountOfVertices) + 1
-		v := rand.Intn(amountOfVertices) + 1
-
-		for u == v {
-			v = rand.Intn(amountOfVertices) + 1
-		}
-
-		uv := graph.NewEdge(u, v)
-		uvCapacity, uvFlow := randCapacityAndFlowValues()
+) *mf.SimpleFlowNetwork[int] {
+	simpleDigraph, err := generate.Complete(amountOfVertices)
 
-		edgesSlice[edgesSliceIndex] = uv
-		edgesSliceIndex++
-		capacity[uv] = uvCapacity
-		flow[uv] = uvFlow
+	if err != nil {
+		panic(err)
 	}
 
-	return mapset.NewFromElements(edgesSlice...), capacity, flow
+	return newCompleteSimpleFlowNetwork(simpleDigraph)
 }
 
-func randCapacityAndFlowValues() (uint32, uint32) {
-	// to avoid overflow in (capacityValue + 1) expression
-	capacityValue := uint32(rand.Int31())
-
-	var flowValue uint32
+func newCompleteSimpleFlowNetwork(
+	simpleDigraph simpledigraph.SimpleDigraph[int],
+) *mf.SimpleFlowNetwork[int] {
+	network, err := generate.NewFlowNetwork(
+		simpleDigraph,
+		1,
+		simpleDigraph.Order(),
+		math.MaxInt32,
+		generate.NewRand(benchmarkSeed),
+	)
 
-	if capacityValue == 0 {
-		flowValue = 0
-	} else {
-		flowValue = rand.Uint32() % (capacityValue + 1)
+	if err != nil {
+		panic(err)
 	}
 
-	return capacityValue, flowValue
+	return network
 }
@@ -0,0 +1,125 @@
+// Package reachability provides transitive closure and transitive reduction
+// of simpledigraph.SimpleDigraph and an index for reachability queries.
+package reachability
+
+import (
+	"goraph/connectivity"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"math/bits"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set"
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// TransitiveClosure creates a simpledigraph.SimpleDigraph with all vertices
+// of simpleDigraph and edge (u, v) for every u != v iff v is reachable from u.
+//
+// Reachability is computed on the condensation of simpleDigraph with bitsets,
+// so vertices of one strongly connected component share the work.
+//
+// It panics if simpleDigraph is nil.
+//
+// Time complexity: O(V + E + C * E' / 64 + closure size), where C and E' are
+// the amounts of vertices and edges of the condensation.
+//
+// https://en.wikipedia.org/wiki/Transitive_closure#In_graph_theory
+func TransitiveClosure[V graph.Vertex](
+	simpleDigraph simpledigraph.SimpleDigraph[V],
+) simpledigraph.SimpleDigraph[V] {
+	assertSimpleDigraphIsNotNil(simpleDigraph)
+
+	components := connectivity.NewTarjan[V]().Compute(simpleDigraph)
+	condensation := connectivity.Condensation(simpleDigraph, components)
+	reachable := reachableComponents(condensation, components.Count())
+	edges := mapset.New[graph.Edge[V]]()
+
+	for c, sourceComponent := range components.Components {
+		for d := range reachable[c].all() {
+			for _, u := range sourceComponent {
+				for _, v := range components.Components[d] {
+					if u != v {
+						edges.Add(graph.NewEdge(u, v))
+					}
+				}
+			}
+		}
+	}
+
+	return newSimpleDigraph(simpleDigraph.Vertices(), edges)
+}
+
+// reachableComponents returns a bitset of components reachable from every
+// component of condensation including itself. Component IDs must be
+// a topological order of condensation.
+func reachableComponents(condensation simpledigraph.SimpleDigraph[int], amountOfComponents int) []bitset {
+	reachable := make([]bitset, amountOfComponents)
+
+	for c := amountOfComponents - 1; c >= 0; c-- {
+		reachable[c] = newBitset(amountOfComponents)
+		reachable[c].add(c)
+
+		for d := range condensation.SuccessorsSeq(c) {
+			reachable[c].union(reachable[d])
+		}
+	}
+
+	return reachable
+}
+
+// bitset is a set of small non-negative integers.
+type bitset []uint64
+
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (b bitset) add(i int) {
+	b[i/64] |= 1 << (i % 64)
+}
+
+func (b bitset) contains(i int) bool {
+	return b[i/64]&(1<<(i%64)) != 0
+}
+
+func (b bitset) union(other bitset) {
+	for i := range b {
+		b[i] |= other[i]
+	}
+}
+
+// all returns all elements of b in increasing order.
+func (b bitset) all() func(yield func(int) bool) {
+	return func(yield func(int) bool) {
+		for i, word := range b {
+			for word != 0 {
+				if !yield(i*64 + bits.TrailingZeros64(word)) {
+					return
+				}
+
+				word &= word - 1
+			}
+		}
+	}
+}
+
+func newSimpleDigraph[V graph.Vertex](
+	vertices set.Set[V],
+	edges set.Set[graph.Edge[V]],
+) simpledigraph.SimpleDigraph[V] {
+	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(vertices, edges)
+
+	// vertices and edges are always consistent here
+	if err != nil {
+		panic(err)
+	}
+
+	return simpleDigraph
+}
+
+func assertSimpleDigraphIsNotNil[V graph.Vertex](simpleDigraph simpledigraph.SimpleDigraph[V]) {
+	if simpleDigraph == nil {
+		panic("simpleDigraph == nil")
+	}
+}
@@ -0,0 +1,166 @@
+package reachability
+
+import (
+	"cmp"
+	"goraph/connectivity"
+	"goraph/graph"
+	"goraph/graph/digraph"
+	"slices"
+	"sort"
+)
+
+// Index answers reachability queries on a static digraph.Digraph.
+//
+// It uses interval labeling of the condensation: components are numbered in
+// postorder of a spanning forest, so every tree is a contiguous interval
+// of numbers, and every component is labeled with merged intervals of all
+// components reachable from it. A query is a binary search in such label.
+//
+// Labels of real-world graphs are usually short, but in the worst case
+// they hold O(V) intervals.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://doi.org/10.1145/66926.66950
+type Index[V graph.Vertex] struct {
+	vertexToComponent map[V]int
+
+	// postorder maps component to its postorder number.
+	postorder []int32
+
+	// intervals holds sorted disjoint intervals of postorder numbers
+	// of components reachable from every component.
+	intervals [][]interval
+}
+
+type interval struct {
+	low  int32
+	high int32
+}
+
+// NewIndex creates Index of digraph.
+//
+// It panics if digraph is nil.
+//
+// Time complexity: O(V + E + L log L), where L is the total length
+// of all labels.
+func NewIndex[V graph.Vertex](digraph digraph.Digraph[V]) *Index[V] {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+
+	components := connectivity.NewTarjan[V]().Compute(digraph)
+	condensation := connectivity.Condensation(digraph, components)
+	n := components.Count()
+
+	index := &Index[V]{
+		vertexToComponent: components.VertexToComponent,
+		postorder:         make([]int32, n),
+		intervals:         make([][]interval, n),
+	}
+
+	// low[c] is the least postorder number in the spanning tree of c
+	low := make([]int32, n)
+	isVisited := make([]bool, n)
+	nextNumber := int32(0)
+
+	type frame struct {
+		c          int
+		successors []int
+	}
+
+	// component IDs are topological, so lesser IDs are roots first
+	for root := 0; root < n; root++ {
+		if isVisited[root] {
+			continue
+		}
+
+		isVisited[root] = true
+		low[root] = nextNumber
+		stack := []frame{{root, slices.Collect(condensation.SuccessorsSeq(root))}}
+
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+
+			if len(top.successors) > 0 {
+				d := top.successors[0]
+				top.successors = top.successors[1:]
+
+				if !isVisited[d] {
+					isVisited[d] = true
+					low[d] = nextNumber
+					stack = append(stack, frame{d, slices.Collect(condensation.SuccessorsSeq(d))})
+				}
+
+				continue
+			}
+
+			index.postorder[top.c] = nextNumber
+			nextNumber++
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	// successors have greater IDs, so their labels are ready
+	for c := n - 1; c >= 0; c-- {
+		label := []interval{{low[c], index.postorder[c]}}
+
+		for d := range condensation.SuccessorsSeq(c) {
+			label = append(label, index.intervals[d]...)
+		}
+
+		index.intervals[c] = merge(label)
+	}
+
+	return index
+}
+
+// merge returns sorted disjoint intervals that cover the same numbers
+// as intervals.
+func merge(intervals []interval) []interval {
+	slices.SortFunc(intervals, func(a, b interval) int {
+		return cmp.Compare(a.low, b.low)
+	})
+
+	merged := intervals[:1]
+
+	for _, next := range intervals[1:] {
+		last := &merged[len(merged)-1]
+
+		if next.low <= last.high+1 {
+			last.high = max(last.high, next.high)
+		} else {
+			merged = append(merged, next)
+		}
+	}
+
+	return slices.Clip(merged)
+}
+
+// Reachable returns true iff there is a path from u to v, every vertex is
+// reachable from itself.
+//
+// It returns false if u or v is not present.
+//
+// Time complexity: O(log L), where L is the length of the label of u.
+func (index *Index[V]) Reachable(u, v V) bool {
+	c, isPresent := index.vertexToComponent[u]
+
+	if !isPresent {
+		return false
+	}
+
+	d, isPresent := index.vertexToComponent[v]
+
+	if !isPresent {
+		return false
+	}
+
+	number := index.postorder[d]
+	label := index.intervals[c]
+	i := sort.Search(len(label), func(i int) bool {
+		return label[i].high >= number
+	})
+
+	return i < len(label) && label[i].low <= number
+}
@@ -0,0 +1,153 @@
+package reachability
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/graph/generate"
+	"goraph/graph/traverse"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+func isReachable(simpleDigraph simpledigraph.SimpleDigraph[int], u, v int) bool {
+	result := traverse.BreadthFirstSearch(simpleDigraph, []int{u}, traverse.Visitor[int]{}, traverse.Options{})
+
+	return result.IsReached(v)
+}
+
+// assertSameReachability checks that every vertex reaches the same
+// vertices by nonempty paths in both digraphs.
+func assertSameReachability(t *testing.T, expected, actual simpledigraph.SimpleDigraph[int]) {
+	assert.Equal(t, expected.Order(), actual.Order())
+
+	for u := range expected.AllVertices() {
+		for v := range expected.AllVertices() {
+			if u != v {
+				assert.Equal(t, isReachable(expected, u, v), isReachable(actual, u, v))
+			}
+		}
+	}
+}
+
+func TestTransitiveClosure(t *testing.T) {
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4, 5),
+		mapset.NewFromElements(
+			graph.NewEdge(1, 2),
+			graph.NewEdge(2, 3),
+			graph.NewEdge(3, 2),
+			graph.NewEdge(3, 4),
+		),
+	)
+
+	closure := TransitiveClosure(simpleDigraph)
+
+	assert.Equal(t, 5, closure.Order())
+	assert.ElementsMatch(
+		t,
+		[]graph.Edge[int]{
+			graph.NewEdge(1, 2),
+			graph.NewEdge(1, 3),
+			graph.NewEdge(1, 4),
+			graph.NewEdge(2, 3),
+			graph.NewEdge(2, 4),
+			graph.NewEdge(3, 2),
+			graph.NewEdge(3, 4),
+		},
+		closure.Edges().Elements(),
+	)
+}
+
+func TestTransitiveReduction(t *testing.T) {
+	dag, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4),
+		mapset.NewFromElements(
+			graph.NewEdge(1, 2),
+			graph.NewEdge(1, 3),
+			graph.NewEdge(1, 4),
+			graph.NewEdge(2, 4),
+			graph.NewEdge(3, 4),
+		),
+	)
+
+	assert.ElementsMatch(
+		t,
+		[]graph.Edge[int]{graph.NewEdge(1, 2), graph.NewEdge(1, 3), graph.NewEdge(2, 4), graph.NewEdge(3, 4)},
+		TransitiveReduction(dag).Edges().Elements(),
+	)
+
+	complete, err := generate.Complete(6)
+
+	assert.Nil(t, err)
+
+	reduction := TransitiveReduction(complete)
+
+	assert.Equal(t, 6, reduction.Size())
+	assertSameReachability(t, complete, reduction)
+}
+
+func TestTransitiveClosure_Random(t *testing.T) {
+	rng := generate.NewRand(1)
+
+	for i := range 30 {
+		simpleDigraph, err := generate.ErdosRenyiGnp(25, 0.02+float64(i%3)*0.03, rng)
+
+		assert.Nil(t, err)
+
+		closure := TransitiveClosure(simpleDigraph)
+		reduction := TransitiveReduction(simpleDigraph)
+
+		assertSameReachability(t, simpleDigraph, closure)
+		assertSameReachability(t, simpleDigraph, reduction)
+		assert.LessOrEqual(t, reduction.Size(), simpleDigraph.Size())
+
+		for edge := range closure.AllEdges() {
+			assert.True(t, isReachable(simpleDigraph, edge.Source(), edge.Target()))
+		}
+
+		// the reduction of a DAG is its subgraph and is irreducible
+		dag, err := generate.RandomDAG(25, 0.2, rng)
+
+		assert.Nil(t, err)
+
+		dagReduction := TransitiveReduction(dag)
+
+		assertSameReachability(t, dag, dagReduction)
+
+		for edge := range dagReduction.AllEdges() {
+			assert.NotNil(t, dag.Edge(edge.Source(), edge.Target()))
+
+			without := simpledigraph.FilterEdges(dagReduction, func(other graph.Edge[int]) bool {
+				return other != edge
+			})
+
+			assert.False(t, isReachable(without, edge.Source(), edge.Target()))
+		}
+	}
+}
+
+func TestIndex_Reachable(t *testing.T) {
+	rng := generate.NewRand(1)
+
+	for i := range 30 {
+		simpleDigraph, err := generate.ErdosRenyiGnp(40, 0.01+float64(i%4)*0.02, rng)
+
+		assert.Nil(t, err)
+
+		index := NewIndex(simpleDigraph)
+
+		for u := range simpleDigraph.AllVertices() {
+			for v := range simpleDigraph.AllVertices() {
+				assert.Equal(t, isReachable(simpleDigraph, u, v), index.Reachable(u, v))
+			}
+		}
+
+		assert.False(t, index.Reachable(0, -1))
+		assert.False(t, index.Reachable(-1, 0))
+	}
+
+	assert.Panics(t, func() { NewIndex[int](nil) })
+}
@@ -0,0 +1,76 @@
+package reachability
+
+import (
+	"goraph/connectivity"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	"slices"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+// TransitiveReduction creates a simpledigraph.SimpleDigraph with all vertices
+// of simpleDigraph, the same reachability and the least amount of edges.
+//
+// If simpleDigraph is a DAG, the result is its unique transitive reduction:
+// edge (u, v) of simpleDigraph is kept iff there is no other path from u to v.
+//
+// Otherwise the reduction is not unique, so every strongly connected component
+// with k > 1 vertices is replaced by a cycle of k edges through its vertices
+// in the order of connectivity.Components (these edges may be absent in
+// simpleDigraph) and every edge of the transitive reduction of the
+// condensation is replaced by one edge of simpleDigraph between its
+// components. The result is a subgraph of simpleDigraph iff it's a DAG.
+//
+// It panics if simpleDigraph is nil.
+//
+// Time complexity: O(V + E + C * E' / 64), where C and E' are the amounts
+// of vertices and edges of the condensation.
+//
+// https://en.wikipedia.org/wiki/Transitive_reduction
+func TransitiveReduction[V graph.Vertex](
+	simpleDigraph simpledigraph.SimpleDigraph[V],
+) simpledigraph.SimpleDigraph[V] {
+	assertSimpleDigraphIsNotNil(simpleDigraph)
+
+	components := connectivity.NewTarjan[V]().Compute(simpleDigraph)
+	condensation := connectivity.Condensation(simpleDigraph, components)
+	reachable := reachableComponents(condensation, components.Count())
+	edges := mapset.New[graph.Edge[V]]()
+
+	// condensation edge -> one edge of simpleDigraph between its components
+	representatives := make(map[graph.Edge[int]]graph.Edge[V])
+
+	for edge := range simpleDigraph.AllEdges() {
+		c := components.VertexToComponent[edge.Source()]
+		d := components.VertexToComponent[edge.Target()]
+
+		if c != d {
+			representatives[graph.NewEdge(c, d)] = edge
+		}
+	}
+
+	for c, component := range components.Components {
+		if len(component) > 1 {
+			for i, u := range component {
+				edges.Add(graph.NewEdge(u, component[(i+1)%len(component)]))
+			}
+		}
+
+		// successors in topological order: d is redundant iff it's reachable
+		// from some earlier kept successor
+		successors := slices.Sorted(condensation.SuccessorsSeq(c))
+		covered := newBitset(components.Count())
+
+		for _, d := range successors {
+			if covered.contains(d) {
+				continue
+			}
+
+			edges.Add(representatives[graph.NewEdge(c, d)])
+			covered.union(reachable[d])
+		}
+	}
+
+	return newSimpleDigraph(simpleDigraph.Vertices(), edges)
+}
@@ -0,0 +1,19 @@
+package shortestpath
+
+import "goraph/graph"
+
+// AllPairs interface represents an all-pairs shortest path algorithm with
+// single method that computes DistanceMatrix of WeightedSimpleDigraph.
+//
+// No implementation can mutate WeightedSimpleDigraph in any way.
+//
+// https://en.wikipedia.org/wiki/Shortest_path_problem#All-pairs_shortest_paths
+type AllPairs[V graph.Vertex, W Weight] interface {
+	// Compute computes DistanceMatrix of this WeightedSimpleDigraph.
+	//
+	// If this WeightedSimpleDigraph has a cycle with negative weight,
+	// it returns *NegativeCycleError with that cycle.
+	//
+	// It panics if digraph is nil.
+	Compute(digraph *WeightedSimpleDigraph[V, W]) (*DistanceMatrix[V, W], error)
+}
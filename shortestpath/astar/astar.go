@@ -0,0 +1,128 @@
+package astar
+
+import (
+	"fmt"
+	"goraph/graph"
+	"goraph/internal/priorityqueue"
+	sp "goraph/shortestpath"
+	"slices"
+)
+
+type aStar[V graph.Vertex, W sp.Weight] struct {
+	heuristic func(vertex V) W
+}
+
+var _ sp.PointToPoint[struct{}, int] = (*aStar[struct{}, int])(nil)
+
+// NewAStar creates an A* search algorithm implementation of
+// shortestpath.PointToPoint, which is goal-directed by heuristic.
+//
+// heuristic must estimate the weight of the shortest path from vertex to
+// target of a query and must be admissible (never overestimate it),
+// then the found path is the shortest one. A vertex is settled again if
+// a shorter path to it is found, so heuristic doesn't have to be consistent.
+// With heuristic that always returns 0 it is Dijkstra's algorithm that stops
+// at target.
+//
+// Compute returns an error if an edge with negative weight is reached.
+//
+// This implementation is immutable and thread-safe if heuristic is.
+//
+// https://en.wikipedia.org/wiki/A*_search_algorithm
+func NewAStar[V graph.Vertex, W sp.Weight](heuristic func(vertex V) W) sp.PointToPoint[V, W] {
+	if heuristic == nil {
+		panic("heuristic == nil")
+	}
+
+	return aStar[V, W]{heuristic}
+}
+
+func (algorithm aStar[V, W]) Compute(
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	source V,
+	target V,
+) (*sp.Path[V, W], error) {
+	assertPreconditions(digraph, source, target)
+
+	path := &sp.Path[V, W]{}
+	vertexToDistance := map[V]W{source: 0}
+	vertexToPredecessor := make(map[V]V)
+	vertexQueue := priorityqueue.NewBinaryHeap[V, W]()
+
+	vertexQueue.Push(source, algorithm.heuristic(source))
+
+	for !vertexQueue.IsEmpty() {
+		u, _ := vertexQueue.Pop()
+		path.SettledVertices++
+
+		if u == target {
+			path.Weight = vertexToDistance[target]
+			path.Edges = pathTo(target, vertexToPredecessor)
+
+			return path, nil
+		}
+
+		uDistance := vertexToDistance[u]
+
+		for v := range digraph.SuccessorsSeq(u) {
+			uv := graph.NewEdge(u, v)
+			uvWeight := digraph.Weight[uv]
+
+			if uvWeight < 0 {
+				return nil, fmt.Errorf("edge %+v has negative weight", uv)
+			}
+
+			vDistance, vIsReached := vertexToDistance[v]
+
+			if !vIsReached || uDistance+uvWeight < vDistance {
+				vertexToDistance[v] = uDistance + uvWeight
+				vertexToPredecessor[v] = u
+				vertexQueue.Push(v, uDistance+uvWeight+algorithm.heuristic(v))
+			}
+		}
+	}
+
+	return path, nil
+}
+
+func pathTo[V graph.Vertex](target V, vertexToPredecessor map[V]V) []graph.Edge[V] {
+	edges := make([]graph.Edge[V], 0)
+	v := target
+	u, uIsPresent := vertexToPredecessor[v]
+
+	for uIsPresent {
+		edges = append(edges, graph.NewEdge(u, v))
+
+		v = u
+		u, uIsPresent = vertexToPredecessor[v]
+	}
+
+	slices.Reverse(edges)
+
+	return edges
+}
+
+func assertPreconditions[V graph.Vertex, W sp.Weight](
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	source V,
+	target V,
+) {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+	if digraph.SimpleDigraph == nil {
+		panic("digraph.SimpleDigraph == nil")
+	}
+	if digraph.Weight == nil {
+		panic("digraph.Weight == nil")
+	}
+
+	vertices := digraph.Vertices()
+
+	if !vertices.Contains(source) {
+		panic("source is not present in digraph")
+	}
+	if !vertices.Contains(target) {
+		panic("target is not present in digraph")
+	}
+}
@@ -0,0 +1,118 @@
+package astar
+
+import (
+	"cmp"
+	"goraph/graph"
+	"goraph/graph/generate"
+	sp "goraph/shortestpath"
+	"goraph/shortestpath/dijkstra"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const gridSize = 30
+
+// newGrid creates gridSize x gridSize grid with random weights in [1, 10]
+// and Manhattan distance heuristic to target, which is admissible.
+func newGrid(seed uint64, target int) (*sp.WeightedSimpleDigraph[int, int], func(int) int) {
+	rng := generate.NewRand(seed)
+	simpleDigraph, err := generate.Grid2D(gridSize, gridSize)
+
+	if err != nil {
+		panic(err)
+	}
+
+	weight := make(sp.Weights[int, int])
+
+	// weights are drawn in a fixed edge order, so the seed defines the grid
+	edges := slices.SortedFunc(simpleDigraph.AllEdges(), func(uv, xy graph.Edge[int]) int {
+		return cmp.Or(cmp.Compare(uv.Source(), xy.Source()), cmp.Compare(uv.Target(), xy.Target()))
+	})
+
+	for _, edge := range edges {
+		weight[edge] = rng.IntN(10) + 1
+	}
+
+	manhattanDistance := func(vertex int) int {
+		dx := (vertex-1)/gridSize - (target-1)/gridSize
+		dy := (vertex-1)%gridSize - (target-1)%gridSize
+
+		return max(dx, -dx) + max(dy, -dy)
+	}
+
+	return &sp.WeightedSimpleDigraph[int, int]{SimpleDigraph: simpleDigraph, Weight: weight}, manhattanDistance
+}
+
+func TestAStar_Compute(t *testing.T) {
+	settledVertices, blindSettledVertices := 0, 0
+
+	for seed := range uint64(10) {
+		source := 1
+		target := gridSize*gridSize - int(seed)
+		digraph, heuristic := newGrid(seed, target)
+
+		paths, _ := dijkstra.NewDijkstra[int, int]().Compute(digraph, source)
+		expectedWeight, _ := paths.Distance(target)
+
+		path, err := NewAStar(heuristic).Compute(digraph, source, target)
+
+		assert.Nil(t, err)
+		assert.True(t, path.IsFound())
+		assert.Equal(t, expectedWeight, path.Weight)
+
+		pathWeight := 0
+
+		for i, edge := range path.Edges {
+			if i > 0 {
+				assert.Equal(t, path.Edges[i-1].Target(), edge.Source())
+			}
+
+			pathWeight += digraph.Weight[edge]
+		}
+
+		assert.Equal(t, expectedWeight, pathWeight)
+
+		blindPath, _ := NewAStar(func(int) int { return 0 }).Compute(digraph, source, target)
+
+		assert.Equal(t, expectedWeight, blindPath.Weight)
+		assert.LessOrEqual(t, path.SettledVertices, blindPath.SettledVertices)
+
+		settledVertices += path.SettledVertices
+		blindSettledVertices += blindPath.SettledVertices
+	}
+
+	// ties between equally distant vertices may settle the target late, but not on every grid
+	assert.Less(t, settledVertices, blindSettledVertices)
+}
+
+func TestAStar_Compute_NotFound(t *testing.T) {
+	simpleDigraph, err := generate.CompleteBipartite(2, 2)
+
+	assert.Nil(t, err)
+
+	digraph := &sp.WeightedSimpleDigraph[int, int]{SimpleDigraph: simpleDigraph, Weight: make(sp.Weights[int, int])}
+
+	for edge := range simpleDigraph.AllEdges() {
+		digraph.Weight[edge] = 1
+	}
+
+	path, err := NewAStar(func(int) int { return 0 }).Compute(digraph, 3, 1)
+
+	assert.Nil(t, err)
+	assert.False(t, path.IsFound())
+
+	path, err = NewAStar(func(int) int { return 0 }).Compute(digraph, 1, 1)
+
+	assert.Nil(t, err)
+	assert.True(t, path.IsFound())
+	assert.Empty(t, path.Edges)
+
+	digraph.Weight[graph.NewEdge(1, 3)] = -1
+
+	path, err = NewAStar(func(int) int { return 0 }).Compute(digraph, 1, 4)
+
+	assert.Nil(t, path)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,162 @@
+package bellmanford
+
+import (
+	"goraph/graph"
+	sp "goraph/shortestpath"
+	"slices"
+)
+
+type bellmanFord[V graph.Vertex, W sp.Weight] struct{}
+
+var _ sp.SingleSource[struct{}, int] = (*bellmanFord[struct{}, int])(nil)
+
+// NewBellmanFord creates a Bellman-Ford algorithm implementation of
+// shortestpath.SingleSource, which allows edges with negative weight.
+//
+// Compute stops as soon as a round relaxes no edge. If a cycle with negative
+// weight is reachable from source, it returns *shortestpath.NegativeCycleError
+// with that cycle.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Bellman%E2%80%93Ford_algorithm
+func NewBellmanFord[V graph.Vertex, W sp.Weight]() sp.SingleSource[V, W] {
+	return bellmanFord[V, W]{}
+}
+
+func (algorithm bellmanFord[V, W]) Compute(
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	source V,
+) (*sp.Paths[V, W], error) {
+	assertPreconditions(digraph, source)
+
+	paths := sp.NewPaths[V, W](source)
+
+	if err := relax(digraph, paths); err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// Potentials computes for every vertex of digraph the weight of the
+// shortest path that ends in it and may start in any vertex, which is the
+// same as Bellman-Ford distances from an extra vertex with zero weight
+// edges to all vertices. Potentials are used by Johnson's algorithm to
+// reweight edges, so that no weight is negative.
+//
+// If digraph has a cycle with negative weight anywhere, it returns
+// *shortestpath.NegativeCycleError with that cycle.
+//
+// https://en.wikipedia.org/wiki/Johnson%27s_algorithm
+func Potentials[V graph.Vertex, W sp.Weight](digraph *sp.WeightedSimpleDigraph[V, W]) (map[V]W, error) {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+
+	paths := &sp.Paths[V, W]{
+		Distances:    make(map[V]W, digraph.Order()),
+		Predecessors: make(map[V]V),
+	}
+
+	for vertex := range digraph.AllVertices() {
+		paths.Distances[vertex] = 0
+	}
+
+	if err := relax(digraph, paths); err != nil {
+		return nil, err
+	}
+
+	return paths.Distances, nil
+}
+
+// relax relaxes all edges in rounds starting from paths until no edge
+// can be relaxed or returns *shortestpath.NegativeCycleError if some edge
+// can still be relaxed after digraph.Order() rounds.
+func relax[V graph.Vertex, W sp.Weight](digraph *sp.WeightedSimpleDigraph[V, W], paths *sp.Paths[V, W]) error {
+	edges := slices.Collect(digraph.AllEdges())
+	order := digraph.Order()
+
+	for round := 1; round <= order; round++ {
+		var lastRelaxedVertex V
+		isRelaxed := false
+
+		for _, uv := range edges {
+			u := uv.Source()
+			v := uv.Target()
+
+			uDistance, uIsReached := paths.Distances[u]
+
+			if !uIsReached {
+				continue
+			}
+
+			vDistance, vIsReached := paths.Distances[v]
+
+			if !vIsReached || uDistance+digraph.Weight[uv] < vDistance {
+				paths.Distances[v] = uDistance + digraph.Weight[uv]
+				paths.Predecessors[v] = u
+				lastRelaxedVertex = v
+				isRelaxed = true
+			}
+		}
+
+		if !isRelaxed {
+			return nil
+		}
+
+		if round == order {
+			return negativeCycle(paths, lastRelaxedVertex, order)
+		}
+	}
+
+	return nil
+}
+
+// negativeCycle finds the cycle in predecessor graph of paths that has been
+// reached from vertex relaxed in the last round.
+func negativeCycle[V graph.Vertex, W sp.Weight](
+	paths *sp.Paths[V, W],
+	relaxedVertex V,
+	order int,
+) *sp.NegativeCycleError[V] {
+	// predecessor chain of relaxedVertex leads to the cycle
+	// in at most order steps
+	cycleVertex := relaxedVertex
+
+	for range order {
+		cycleVertex = paths.Predecessors[cycleVertex]
+	}
+
+	cycle := make([]graph.Edge[V], 0)
+	v := cycleVertex
+
+	for {
+		u := paths.Predecessors[v]
+		cycle = append(cycle, graph.NewEdge(u, v))
+		v = u
+
+		if v == cycleVertex {
+			break
+		}
+	}
+
+	slices.Reverse(cycle)
+
+	return &sp.NegativeCycleError[V]{Cycle: cycle}
+}
+
+func assertPreconditions[V graph.Vertex, W sp.Weight](digraph *sp.WeightedSimpleDigraph[V, W], source V) {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+	if digraph.SimpleDigraph == nil {
+		panic("digraph.SimpleDigraph == nil")
+	}
+	if digraph.Weight == nil {
+		panic("digraph.Weight == nil")
+	}
+	if !digraph.Vertices().Contains(source) {
+		panic("source is not present in digraph")
+	}
+}
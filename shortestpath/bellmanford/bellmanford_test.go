@@ -0,0 +1,134 @@
+package bellmanford
+
+import (
+	"goraph/graph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	sp "goraph/shortestpath"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+func newWeightedSimpleDigraph(weight sp.Weights[int, int], vertices ...int) *sp.WeightedSimpleDigraph[int, int] {
+	edges := mapset.New[graph.Edge[int]]()
+
+	for edge := range weight {
+		edges.Add(edge)
+	}
+
+	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(mapset.NewFromElements(vertices...), edges)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &sp.WeightedSimpleDigraph[int, int]{SimpleDigraph: simpleDigraph, Weight: weight}
+}
+
+func TestBellmanFord_Compute(t *testing.T) {
+	digraph := newWeightedSimpleDigraph(
+		sp.Weights[int, int]{
+			graph.NewEdge(1, 2): 4,
+			graph.NewEdge(1, 3): 5,
+			graph.NewEdge(3, 2): -3,
+			graph.NewEdge(2, 4): 2,
+			graph.NewEdge(4, 5): -1,
+		},
+		1, 2, 3, 4, 5, 6,
+	)
+
+	paths, err := NewBellmanFord[int, int]().Compute(digraph, 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]int{1: 0, 2: 2, 3: 5, 4: 4, 5: 3}, paths.Distances)
+	assert.Equal(
+		t,
+		[]graph.Edge[int]{graph.NewEdge(1, 3), graph.NewEdge(3, 2), graph.NewEdge(2, 4), graph.NewEdge(4, 5)},
+		paths.PathTo(5),
+	)
+	assert.Nil(t, paths.PathTo(6))
+}
+
+func TestBellmanFord_Compute_NegativeCycle(t *testing.T) {
+	digraph := newWeightedSimpleDigraph(
+		sp.Weights[int, int]{
+			graph.NewEdge(1, 2): 1,
+			graph.NewEdge(2, 3): 1,
+			graph.NewEdge(3, 4): -3,
+			graph.NewEdge(4, 2): 1,
+			graph.NewEdge(4, 5): 1,
+		},
+		1, 2, 3, 4, 5,
+	)
+
+	paths, err := NewBellmanFord[int, int]().Compute(digraph, 1)
+
+	assert.Nil(t, paths)
+
+	var negativeCycleError *sp.NegativeCycleError[int]
+
+	assert.ErrorAs(t, err, &negativeCycleError)
+	assert.Len(t, negativeCycleError.Cycle, 3)
+
+	cycleWeight := 0
+
+	for i, edge := range negativeCycleError.Cycle {
+		next := negativeCycleError.Cycle[(i+1)%len(negativeCycleError.Cycle)]
+
+		assert.Equal(t, edge.Target(), next.Source())
+
+		cycleWeight += digraph.Weight[edge]
+	}
+
+	assert.Less(t, cycleWeight, 0)
+
+	// the cycle is not reachable from 5
+	paths, err = NewBellmanFord[int, int]().Compute(digraph, 5)
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]int{5: 0}, paths.Distances)
+}
+
+func TestPotentials(t *testing.T) {
+	digraph := newWeightedSimpleDigraph(
+		sp.Weights[int, int]{
+			graph.NewEdge(1, 2): -2,
+			graph.NewEdge(2, 3): 3,
+			graph.NewEdge(3, 1): 1,
+			graph.NewEdge(4, 3): -4,
+		},
+		1, 2, 3, 4,
+	)
+
+	potentials, err := Potentials(digraph)
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]int{1: -3, 2: -5, 3: -4, 4: 0}, potentials)
+
+	for edge, weight := range digraph.Weight {
+		assert.GreaterOrEqual(t, weight+potentials[edge.Source()]-potentials[edge.Target()], 0)
+	}
+
+	digraph.Weight[graph.NewEdge(3, 1)] = -2
+
+	potentials, err = Potentials(digraph)
+
+	assert.Nil(t, potentials)
+	assert.Equal(
+		t,
+		&sp.NegativeCycleError[int]{Cycle: []graph.Edge[int]{graph.NewEdge(1, 2), graph.NewEdge(2, 3), graph.NewEdge(3, 1)}},
+		rotated(err.(*sp.NegativeCycleError[int]), 1),
+	)
+}
+
+// rotated rotates the cycle of err so that it starts with vertex.
+func rotated(err *sp.NegativeCycleError[int], vertex int) *sp.NegativeCycleError[int] {
+	for i, edge := range err.Cycle {
+		if edge.Source() == vertex {
+			return &sp.NegativeCycleError[int]{Cycle: append(err.Cycle[i:], err.Cycle[:i]...)}
+		}
+	}
+
+	return err
+}
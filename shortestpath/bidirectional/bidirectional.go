@@ -0,0 +1,186 @@
+package bidirectional
+
+import (
+	"fmt"
+	"goraph/graph"
+	"goraph/internal/priorityqueue"
+	sp "goraph/shortestpath"
+	"iter"
+	"slices"
+)
+
+type bidirectionalDijkstra[V graph.Vertex, W sp.Weight] struct{}
+
+var _ sp.PointToPoint[struct{}, int] = (*bidirectionalDijkstra[struct{}, int])(nil)
+
+// NewBidirectionalDijkstra creates a bidirectional Dijkstra's algorithm
+// implementation of shortestpath.PointToPoint. It runs the forward search
+// from source over successors and the backward search from target over
+// predecessors, always expanding the one with the lesser tentative distance,
+// and stops when the sum of both tentative distances is not less than
+// the shortest path found so far.
+//
+// Compute returns an error if an edge with negative weight is reached.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Bidirectional_search
+func NewBidirectionalDijkstra[V graph.Vertex, W sp.Weight]() sp.PointToPoint[V, W] {
+	return bidirectionalDijkstra[V, W]{}
+}
+
+// search is a state of one direction of bidirectional Dijkstra's algorithm.
+type search[V graph.Vertex, W sp.Weight] struct {
+	vertexToDistance map[V]W
+
+	// vertexToParent maps vertex to its predecessor in the forward search
+	// and to its successor in the backward search.
+	vertexToParent map[V]V
+
+	settledVertices map[V]struct{}
+	vertexQueue     priorityqueue.PriorityQueue[V, W]
+
+	neighbors func(vertex V) iter.Seq[V]
+
+	// edge returns the edge between vertex and its neighbor.
+	edge func(vertex, neighbor V) graph.Edge[V]
+}
+
+func newSearch[V graph.Vertex, W sp.Weight](
+	start V,
+	neighbors func(vertex V) iter.Seq[V],
+	edge func(vertex, neighbor V) graph.Edge[V],
+) *search[V, W] {
+	search := &search[V, W]{
+		vertexToDistance: map[V]W{start: 0},
+		vertexToParent:   make(map[V]V),
+		settledVertices:  make(map[V]struct{}),
+		vertexQueue:      priorityqueue.NewBinaryHeap[V, W](),
+		neighbors:        neighbors,
+		edge:             edge,
+	}
+
+	search.vertexQueue.Push(start, 0)
+
+	return search
+}
+
+func (algorithm bidirectionalDijkstra[V, W]) Compute(
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	source V,
+	target V,
+) (*sp.Path[V, W], error) {
+	assertPreconditions(digraph, source, target)
+
+	path := &sp.Path[V, W]{}
+
+	if source == target {
+		path.Edges = make([]graph.Edge[V], 0)
+
+		return path, nil
+	}
+
+	forward := newSearch[V, W](source, digraph.SuccessorsSeq, graph.NewEdge[V])
+	backward := newSearch[V, W](target, digraph.PredecessorsSeq, func(vertex, neighbor V) graph.Edge[V] {
+		return graph.NewEdge(neighbor, vertex)
+	})
+
+	var bestWeight W
+	var meetingVertex V
+	isFound := false
+
+	for !forward.vertexQueue.IsEmpty() && !backward.vertexQueue.IsEmpty() {
+		_, forwardDistance := forward.vertexQueue.Peek()
+		_, backwardDistance := backward.vertexQueue.Peek()
+
+		if isFound && forwardDistance+backwardDistance >= bestWeight {
+			break
+		}
+
+		current, opposite := forward, backward
+
+		if backwardDistance < forwardDistance {
+			current, opposite = backward, forward
+		}
+
+		u, uDistance := current.vertexQueue.Pop()
+		current.settledVertices[u] = struct{}{}
+		path.SettledVertices++
+
+		for v := range current.neighbors(u) {
+			uv := current.edge(u, v)
+			uvWeight := digraph.Weight[uv]
+
+			if uvWeight < 0 {
+				return nil, fmt.Errorf("edge %+v has negative weight", uv)
+			}
+
+			if _, vIsSettled := current.settledVertices[v]; vIsSettled {
+				continue
+			}
+
+			vDistance, vIsReached := current.vertexToDistance[v]
+
+			if !vIsReached || uDistance+uvWeight < vDistance {
+				vDistance = uDistance + uvWeight
+				current.vertexToDistance[v] = vDistance
+				current.vertexToParent[v] = u
+				current.vertexQueue.Push(v, vDistance)
+			}
+
+			if vOppositeDistance, vIsReachedByOpposite := opposite.vertexToDistance[v]; vIsReachedByOpposite &&
+				(!isFound || vDistance+vOppositeDistance < bestWeight) {
+				bestWeight = vDistance + vOppositeDistance
+				meetingVertex = v
+				isFound = true
+			}
+		}
+	}
+
+	if !isFound {
+		return path, nil
+	}
+
+	// source -> meetingVertex part
+	path.Edges = make([]graph.Edge[V], 0)
+
+	for v := meetingVertex; v != source; v = forward.vertexToParent[v] {
+		path.Edges = append(path.Edges, graph.NewEdge(forward.vertexToParent[v], v))
+	}
+
+	slices.Reverse(path.Edges)
+
+	// meetingVertex -> target part
+	for u := meetingVertex; u != target; u = backward.vertexToParent[u] {
+		path.Edges = append(path.Edges, graph.NewEdge(u, backward.vertexToParent[u]))
+	}
+
+	path.Weight = bestWeight
+
+	return path, nil
+}
+
+func assertPreconditions[V graph.Vertex, W sp.Weight](
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	source V,
+	target V,
+) {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+	if digraph.SimpleDigraph == nil {
+		panic("digraph.SimpleDigraph == nil")
+	}
+	if digraph.Weight == nil {
+		panic("digraph.Weight == nil")
+	}
+
+	vertices := digraph.Vertices()
+
+	if !vertices.Contains(source) {
+		panic("source is not present in digraph")
+	}
+	if !vertices.Contains(target) {
+		panic("target is not present in digraph")
+	}
+}
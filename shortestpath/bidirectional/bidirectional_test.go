@@ -0,0 +1,99 @@
+package bidirectional
+
+import (
+	"goraph/graph"
+	"goraph/graph/generate"
+	sp "goraph/shortestpath"
+	"goraph/shortestpath/dijkstra"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBidirectionalDijkstra_Compute(t *testing.T) {
+	rng := generate.NewRand(1)
+
+	for range 20 {
+		simpleDigraph, err := generate.ErdosRenyiGnm(60, 200, rng)
+
+		assert.Nil(t, err)
+
+		digraph := &sp.WeightedSimpleDigraph[int, int]{SimpleDigraph: simpleDigraph, Weight: make(sp.Weights[int, int])}
+
+		for edge := range simpleDigraph.AllEdges() {
+			digraph.Weight[edge] = rng.IntN(20)
+		}
+
+		source := rng.IntN(60) + 1
+		paths, _ := dijkstra.NewDijkstra[int, int]().Compute(digraph, source)
+
+		for target := range simpleDigraph.AllVertices() {
+			expectedWeight, isReachable := paths.Distance(target)
+
+			path, err := NewBidirectionalDijkstra[int, int]().Compute(digraph, source, target)
+
+			assert.Nil(t, err)
+			assert.Equal(t, isReachable, path.IsFound())
+
+			if !isReachable {
+				continue
+			}
+
+			assert.Equal(t, expectedWeight, path.Weight)
+
+			pathWeight := 0
+			v := source
+
+			for _, edge := range path.Edges {
+				assert.Equal(t, v, edge.Source())
+				assert.NotNil(t, simpleDigraph.Edge(edge.Source(), edge.Target()))
+
+				pathWeight += digraph.Weight[edge]
+				v = edge.Target()
+			}
+
+			assert.Equal(t, target, v)
+			assert.Equal(t, expectedWeight, pathWeight)
+		}
+	}
+}
+
+func TestBidirectionalDijkstra_Compute_Grid(t *testing.T) {
+	simpleDigraph, err := generate.Grid2D(50, 50)
+
+	assert.Nil(t, err)
+
+	digraph := &sp.WeightedSimpleDigraph[int, int]{SimpleDigraph: simpleDigraph, Weight: make(sp.Weights[int, int])}
+
+	for edge := range simpleDigraph.AllEdges() {
+		digraph.Weight[edge] = 1
+	}
+
+	path, err := NewBidirectionalDijkstra[int, int]().Compute(digraph, 1, 50)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 49, path.Weight)
+	assert.Len(t, path.Edges, 49)
+
+	// unidirectional search settles all 1275 vertices within distance 49 of 1
+	assert.Less(t, path.SettledVertices, 1225+50)
+}
+
+func TestBidirectionalDijkstra_Compute_NegativeWeight(t *testing.T) {
+	simpleDigraph, err := generate.Complete(3)
+
+	assert.Nil(t, err)
+
+	digraph := &sp.WeightedSimpleDigraph[int, int]{SimpleDigraph: simpleDigraph, Weight: make(sp.Weights[int, int])}
+
+	for edge := range simpleDigraph.AllEdges() {
+		digraph.Weight[edge] = 1
+	}
+
+	digraph.Weight[graph.NewEdge(1, 2)] = -1
+
+	path, err := NewBidirectionalDijkstra[int, int]().Compute(digraph, 1, 3)
+
+	assert.Nil(t, path)
+	assert.NotNil(t, err)
+}
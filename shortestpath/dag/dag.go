@@ -0,0 +1,102 @@
+package dag
+
+import (
+	"errors"
+	"goraph/graph"
+	"goraph/graph/traverse"
+	sp "goraph/shortestpath"
+	"slices"
+)
+
+type dag[V graph.Vertex, W sp.Weight] struct {
+	// isBetter returns true iff path of weight a is better than path of weight b.
+	isBetter func(a, b W) bool
+}
+
+var _ sp.SingleSource[struct{}, int] = (*dag[struct{}, int])(nil)
+
+// NewDAGShortestPaths creates an implementation of shortestpath.SingleSource
+// for directed acyclic graphs that relaxes edges in topological order in
+// O(n + m) time, edges with negative weight are allowed.
+//
+// Compute returns an error if a cycle is reachable from source.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Directed_acyclic_graph#Path_algorithms
+func NewDAGShortestPaths[V graph.Vertex, W sp.Weight]() sp.SingleSource[V, W] {
+	return dag[V, W]{func(a, b W) bool { return a < b }}
+}
+
+// NewDAGLongestPaths creates the same shortestpath.SingleSource as
+// NewDAGShortestPaths, but it computes longest (maximum weight) paths.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Longest_path_problem#Acyclic_graphs
+func NewDAGLongestPaths[V graph.Vertex, W sp.Weight]() sp.SingleSource[V, W] {
+	return dag[V, W]{func(a, b W) bool { return a > b }}
+}
+
+func (algorithm dag[V, W]) Compute(
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	source V,
+) (*sp.Paths[V, W], error) {
+	assertPreconditions(digraph, source)
+
+	// reverse postorder of DFS is a topological order
+	postorder := make([]V, 0)
+
+	result := traverse.DepthFirstSearch(
+		digraph,
+		[]V{source},
+		traverse.Visitor[V]{
+			FinishVertex: func(vertex V) bool {
+				postorder = append(postorder, vertex)
+
+				return true
+			},
+			BackEdge: func(graph.Edge[V]) bool {
+				return false
+			},
+		},
+		traverse.Options{},
+	)
+
+	if result.Stopped {
+		return nil, errors.New("cycle is reachable from source")
+	}
+
+	paths := sp.NewPaths[V, W](source)
+
+	for _, u := range slices.Backward(postorder) {
+		uDistance := paths.Distances[u]
+
+		for v := range digraph.SuccessorsSeq(u) {
+			uv := graph.NewEdge(u, v)
+			vDistance, vIsReached := paths.Distances[v]
+
+			if !vIsReached || algorithm.isBetter(uDistance+digraph.Weight[uv], vDistance) {
+				paths.Distances[v] = uDistance + digraph.Weight[uv]
+				paths.Predecessors[v] = u
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+func assertPreconditions[V graph.Vertex, W sp.Weight](digraph *sp.WeightedSimpleDigraph[V, W], source V) {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+	if digraph.SimpleDigraph == nil {
+		panic("digraph.SimpleDigraph == nil")
+	}
+	if digraph.Weight == nil {
+		panic("digraph.Weight == nil")
+	}
+	if !digraph.Vertices().Contains(source) {
+		panic("source is not present in digraph")
+	}
+}
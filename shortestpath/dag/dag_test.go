@@ -0,0 +1,92 @@
+package dag
+
+import (
+	"goraph/graph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	sp "goraph/shortestpath"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+func newWeightedSimpleDigraph(weight sp.Weights[int, int], vertices ...int) *sp.WeightedSimpleDigraph[int, int] {
+	edges := mapset.New[graph.Edge[int]]()
+
+	for edge := range weight {
+		edges.Add(edge)
+	}
+
+	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(mapset.NewFromElements(vertices...), edges)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &sp.WeightedSimpleDigraph[int, int]{SimpleDigraph: simpleDigraph, Weight: weight}
+}
+
+func TestDAG_Compute(t *testing.T) {
+	digraph := newWeightedSimpleDigraph(
+		sp.Weights[int, int]{
+			graph.NewEdge(1, 2): 5,
+			graph.NewEdge(1, 3): 3,
+			graph.NewEdge(2, 4): 6,
+			graph.NewEdge(2, 3): 2,
+			graph.NewEdge(3, 5): 4,
+			graph.NewEdge(3, 6): 2,
+			graph.NewEdge(3, 4): 7,
+			graph.NewEdge(4, 5): -1,
+			graph.NewEdge(5, 6): -2,
+		},
+		1, 2, 3, 4, 5, 6,
+	)
+
+	shortestPaths, err := NewDAGShortestPaths[int, int]().Compute(digraph, 2)
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]int{2: 0, 3: 2, 4: 6, 5: 5, 6: 3}, shortestPaths.Distances)
+	assert.Equal(
+		t,
+		[]graph.Edge[int]{graph.NewEdge(2, 4), graph.NewEdge(4, 5)},
+		shortestPaths.PathTo(5),
+	)
+
+	longestPaths, err := NewDAGLongestPaths[int, int]().Compute(digraph, 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]int{1: 0, 2: 5, 3: 7, 4: 14, 5: 13, 6: 11}, longestPaths.Distances)
+	assert.Equal(
+		t,
+		[]graph.Edge[int]{
+			graph.NewEdge(1, 2),
+			graph.NewEdge(2, 3),
+			graph.NewEdge(3, 4),
+			graph.NewEdge(4, 5),
+			graph.NewEdge(5, 6),
+		},
+		longestPaths.PathTo(6),
+	)
+}
+
+func TestDAG_Compute_Cycle(t *testing.T) {
+	digraph := newWeightedSimpleDigraph(
+		sp.Weights[int, int]{
+			graph.NewEdge(1, 2): 1,
+			graph.NewEdge(2, 3): 1,
+			graph.NewEdge(3, 2): 1,
+			graph.NewEdge(4, 1): 1,
+		},
+		1, 2, 3, 4,
+	)
+
+	paths, err := NewDAGShortestPaths[int, int]().Compute(digraph, 1)
+
+	assert.Nil(t, paths)
+	assert.NotNil(t, err)
+
+	paths, err = NewDAGLongestPaths[int, int]().Compute(digraph, 4)
+
+	assert.Nil(t, paths)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,94 @@
+package dijkstra
+
+import (
+	"fmt"
+	"goraph/graph"
+	"goraph/internal/priorityqueue"
+	sp "goraph/shortestpath"
+)
+
+type dijkstra[V graph.Vertex, W sp.Weight] struct {
+	newPriorityQueue func() priorityqueue.PriorityQueue[V, W]
+}
+
+var _ sp.SingleSource[struct{}, int] = (*dijkstra[struct{}, int])(nil)
+
+// NewDijkstra creates a Dijkstra's algorithm implementation of
+// shortestpath.SingleSource using binary heap.
+//
+// Compute returns an error if an edge with negative weight is reachable
+// from source.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Dijkstra%27s_algorithm
+func NewDijkstra[V graph.Vertex, W sp.Weight]() sp.SingleSource[V, W] {
+	return dijkstra[V, W]{priorityqueue.NewBinaryHeap[V, W]}
+}
+
+// NewPairingHeapDijkstra creates the same shortestpath.SingleSource as
+// NewDijkstra, but using pairing heap, which has O(1) amortized
+// decrease-key, so it may be faster on dense graphs.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Pairing_heap
+func NewPairingHeapDijkstra[V graph.Vertex, W sp.Weight]() sp.SingleSource[V, W] {
+	return dijkstra[V, W]{priorityqueue.NewPairingHeap[V, W]}
+}
+
+func (algorithm dijkstra[V, W]) Compute(
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	source V,
+) (*sp.Paths[V, W], error) {
+	assertPreconditions(digraph, source)
+
+	paths := sp.NewPaths[V, W](source)
+	settledVertices := make(map[V]struct{})
+	vertexQueue := algorithm.newPriorityQueue()
+
+	vertexQueue.Push(source, 0)
+
+	for !vertexQueue.IsEmpty() {
+		u, uDistance := vertexQueue.Pop()
+		settledVertices[u] = struct{}{}
+
+		for v := range digraph.SuccessorsSeq(u) {
+			uv := graph.NewEdge(u, v)
+			uvWeight := digraph.Weight[uv]
+
+			if uvWeight < 0 {
+				return nil, fmt.Errorf("edge %+v has negative weight", uv)
+			}
+
+			if _, vIsSettled := settledVertices[v]; vIsSettled {
+				continue
+			}
+
+			vDistance, vIsReached := paths.Distances[v]
+
+			if !vIsReached || uDistance+uvWeight < vDistance {
+				paths.Distances[v] = uDistance + uvWeight
+				paths.Predecessors[v] = u
+				vertexQueue.Push(v, uDistance+uvWeight)
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+func assertPreconditions[V graph.Vertex, W sp.Weight](digraph *sp.WeightedSimpleDigraph[V, W], source V) {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+	if digraph.SimpleDigraph == nil {
+		panic("digraph.SimpleDigraph == nil")
+	}
+	if digraph.Weight == nil {
+		panic("digraph.Weight == nil")
+	}
+	if !digraph.Vertices().Contains(source) {
+		panic("source is not present in digraph")
+	}
+}
@@ -0,0 +1,117 @@
+package dijkstra
+
+import (
+	"goraph/graph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/graph/generate"
+	sp "goraph/shortestpath"
+	"goraph/shortestpath/bellmanford"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+func newWeightedSimpleDigraph(weight sp.Weights[string, float64], vertices ...string) *sp.WeightedSimpleDigraph[string, float64] {
+	edges := mapset.New[graph.Edge[string]]()
+
+	for edge := range weight {
+		edges.Add(edge)
+	}
+
+	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(mapset.NewFromElements(vertices...), edges)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return &sp.WeightedSimpleDigraph[string, float64]{SimpleDigraph: simpleDigraph, Weight: weight}
+}
+
+func TestDijkstra_Compute(t *testing.T) {
+	digraph := newWeightedSimpleDigraph(
+		sp.Weights[string, float64]{
+			graph.NewEdge("s", "a"): 7,
+			graph.NewEdge("s", "b"): 2,
+			graph.NewEdge("b", "a"): 3,
+			graph.NewEdge("a", "t"): 1,
+			graph.NewEdge("b", "t"): 8,
+		},
+		"s", "a", "b", "t", "u",
+	)
+
+	for _, algorithm := range []sp.SingleSource[string, float64]{
+		NewDijkstra[string, float64](),
+		NewPairingHeapDijkstra[string, float64](),
+	} {
+		paths, err := algorithm.Compute(digraph, "s")
+
+		assert.Nil(t, err)
+
+		distance, isReachable := paths.Distance("t")
+
+		assert.True(t, isReachable)
+		assert.Equal(t, 6.0, distance)
+		assert.Equal(
+			t,
+			[]graph.Edge[string]{graph.NewEdge("s", "b"), graph.NewEdge("b", "a"), graph.NewEdge("a", "t")},
+			paths.PathTo("t"),
+		)
+		assert.Empty(t, paths.PathTo("s"))
+		assert.NotNil(t, paths.PathTo("s"))
+
+		_, isReachable = paths.Distance("u")
+
+		assert.False(t, isReachable)
+		assert.Nil(t, paths.PathTo("u"))
+	}
+}
+
+func TestDijkstra_Compute_NegativeWeight(t *testing.T) {
+	digraph := newWeightedSimpleDigraph(
+		sp.Weights[string, float64]{graph.NewEdge("s", "t"): -1},
+		"s", "t",
+	)
+
+	paths, err := NewDijkstra[string, float64]().Compute(digraph, "s")
+
+	assert.Nil(t, paths)
+	assert.NotNil(t, err)
+}
+
+func TestDijkstra_Compute_Random(t *testing.T) {
+	rng := generate.NewRand(1)
+
+	for range 20 {
+		network, err := generate.RandomFlowNetwork(30, 150, 100, rng)
+
+		assert.Nil(t, err)
+
+		digraph := &sp.WeightedSimpleDigraph[int, uint32]{
+			SimpleDigraph: network.SimpleDigraph,
+			Weight:        sp.Weights[int, uint32](network.Capacity),
+		}
+
+		expected, _ := bellmanford.NewBellmanFord[int, uint32]().Compute(digraph, network.S)
+
+		for _, algorithm := range []sp.SingleSource[int, uint32]{
+			NewDijkstra[int, uint32](),
+			NewPairingHeapDijkstra[int, uint32](),
+		} {
+			paths, err := algorithm.Compute(digraph, network.S)
+
+			assert.Nil(t, err)
+			assert.Equal(t, expected.Distances, paths.Distances)
+
+			for vertex, distance := range paths.Distances {
+				var pathWeight uint32
+
+				for _, edge := range paths.PathTo(vertex) {
+					pathWeight += digraph.Weight[edge]
+				}
+
+				assert.Equal(t, distance, pathWeight)
+			}
+		}
+	}
+}
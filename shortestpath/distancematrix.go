@@ -0,0 +1,84 @@
+package shortestpath
+
+import (
+	"goraph/graph"
+	"slices"
+)
+
+// NoPredecessor is a value of DistanceMatrix.Predecessors cell with no path.
+const NoPredecessor = -1
+
+// DistanceMatrix holds shortest paths between all pairs of vertices in
+// row-major n x n matrices, where n is Indexer.Len() and vertices are
+// identified by their Indexer indices.
+type DistanceMatrix[V graph.Vertex, W Weight] struct {
+	Indexer *graph.Indexer[V]
+
+	// Distances[i*n + j] is the weight of the shortest path from i to j,
+	// it is meaningless if j is not reachable from i.
+	Distances []W
+
+	// Predecessors[i*n + j] is the predecessor of j on the shortest path
+	// from i to j or NoPredecessor if i == j or j is not reachable from i.
+	Predecessors []int32
+}
+
+// NewDistanceMatrix creates DistanceMatrix of vertices of indexer where
+// only the empty paths from every vertex to itself are present.
+func NewDistanceMatrix[V graph.Vertex, W Weight](indexer *graph.Indexer[V]) *DistanceMatrix[V, W] {
+	n := indexer.Len()
+	predecessors := make([]int32, n*n)
+
+	for i := range predecessors {
+		predecessors[i] = NoPredecessor
+	}
+
+	return &DistanceMatrix[V, W]{
+		Indexer:      indexer,
+		Distances:    make([]W, n*n),
+		Predecessors: predecessors,
+	}
+}
+
+// IsReachable returns true iff there is a path from vertex with index i
+// to vertex with index j.
+func (matrix *DistanceMatrix[V, W]) IsReachable(i, j int) bool {
+	return i == j || matrix.Predecessors[i*matrix.Indexer.Len()+j] != NoPredecessor
+}
+
+// Distance returns the weight of the shortest path from u to v and true
+// iff both vertices are present and v is reachable from u.
+func (matrix *DistanceMatrix[V, W]) Distance(u, v V) (W, bool) {
+	i, uIsPresent := matrix.Indexer.Index(u)
+	j, vIsPresent := matrix.Indexer.Index(v)
+
+	if !uIsPresent || !vIsPresent || !matrix.IsReachable(i, j) {
+		return 0, false
+	}
+
+	return matrix.Distances[i*matrix.Indexer.Len()+j], true
+}
+
+// Path returns edges of the shortest path from u to v, it is empty if
+// u == v and nil if v is not reachable from u.
+func (matrix *DistanceMatrix[V, W]) Path(u, v V) []graph.Edge[V] {
+	i, uIsPresent := matrix.Indexer.Index(u)
+	j, vIsPresent := matrix.Indexer.Index(v)
+
+	if !uIsPresent || !vIsPresent || !matrix.IsReachable(i, j) {
+		return nil
+	}
+
+	n := matrix.Indexer.Len()
+	path := make([]graph.Edge[V], 0)
+
+	for j != i {
+		predecessor := int(matrix.Predecessors[i*n+j])
+		path = append(path, graph.NewEdge(matrix.Indexer.Vertex(predecessor), matrix.Indexer.Vertex(j)))
+		j = predecessor
+	}
+
+	slices.Reverse(path)
+
+	return path
+}
@@ -0,0 +1,366 @@
+package eppstein
+
+import (
+	"cmp"
+	"fmt"
+	"goraph/graph"
+	"goraph/internal/priorityqueue"
+	sp "goraph/shortestpath"
+	"iter"
+	"slices"
+)
+
+type eppstein[V graph.Vertex, W sp.Weight] struct{}
+
+var _ sp.KShortest[struct{}, int] = (*eppstein[struct{}, int])(nil)
+
+// NewEppstein creates an Eppstein's algorithm implementation of
+// shortestpath.KShortest, which enumerates walks, so yielded paths may
+// contain cycles and the iter.Seq is infinite if a cycle is reachable from
+// source and can reach target.
+//
+// Every walk is represented by its sequence of sidetracks (edges that are
+// not in the shortest path tree to target), which are kept in persistent
+// leftist heaps, so the next walk is found in O(log n) after O(m log n)
+// preprocessing (plus the time to build a walk).
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/K_shortest_path_routing#Algorithm
+func NewEppstein[V graph.Vertex, W sp.Weight]() sp.KShortest[V, W] {
+	return eppstein[V, W]{}
+}
+
+// sidetrack is an edge that is not in the shortest path tree with delta,
+// which is the extra weight of a walk that takes it.
+type sidetrack[V graph.Vertex, W sp.Weight] struct {
+	edge  graph.Edge[V]
+	delta W
+}
+
+// heapNode is a node of persistent leftist min heap H_T(v) of vertices on
+// the tree path from v to target keyed by their best sidetrack.
+type heapNode[V graph.Vertex, W sp.Weight] struct {
+	vertex V
+	key    W
+	left   *heapNode[V, W]
+	right  *heapNode[V, W]
+	rank   int
+}
+
+// candidate is a node of Eppstein's path graph: sidetrack index of
+// node.vertex sidetracks that is the last sidetrack of a walk.
+type candidate[V graph.Vertex, W sp.Weight] struct {
+	node   *heapNode[V, W]
+	index  int
+	weight W
+
+	// previousSidetracks are sidetracks of the walk before the last one.
+	previousSidetracks *sidetrackList[V]
+}
+
+// sidetrackList is a persistent linked list of sidetracks in reverse order.
+type sidetrackList[V graph.Vertex] struct {
+	edge     graph.Edge[V]
+	previous *sidetrackList[V]
+}
+
+// shortestPathTree holds shortest paths from all vertices to target.
+type shortestPathTree[V graph.Vertex, W sp.Weight] struct {
+	target           V
+	vertexToDistance map[V]W
+
+	// vertexToNext maps every vertex except target to its successor
+	// on the shortest path to target.
+	vertexToNext map[V]V
+}
+
+func (algorithm eppstein[V, W]) Compute(
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	source V,
+	target V,
+) (iter.Seq[*sp.Path[V, W]], error) {
+	assertPreconditions(digraph, source, target)
+
+	for edge := range digraph.AllEdges() {
+		if digraph.Weight[edge] < 0 {
+			return nil, fmt.Errorf("edge %+v has negative weight", edge)
+		}
+	}
+
+	return func(yield func(*sp.Path[V, W]) bool) {
+		tree := newShortestPathTree(digraph, target)
+		sourceDistance, sourceReachesTarget := tree.vertexToDistance[source]
+
+		if !sourceReachesTarget {
+			return
+		}
+
+		if !yield(&sp.Path[V, W]{Edges: tree.walk(source, nil), Weight: sourceDistance}) {
+			return
+		}
+
+		vertexToSidetracks := sidetracks(digraph, tree)
+		vertexToHeap := make(map[V]*heapNode[V, W])
+		candidates := priorityqueue.NewBinaryHeap[*candidate[V, W], W]()
+
+		heapOf := func(vertex V) *heapNode[V, W] {
+			return treeHeap(vertex, tree, vertexToSidetracks, vertexToHeap)
+		}
+
+		if root := heapOf(source); root != nil {
+			candidates.Push(&candidate[V, W]{node: root, weight: sourceDistance + root.key}, sourceDistance+root.key)
+		}
+
+		for !candidates.IsEmpty() {
+			current, _ := candidates.Pop()
+			currentSidetracks := vertexToSidetracks[current.node.vertex]
+			lastSidetrack := currentSidetracks[current.index]
+			sidetracks := &sidetrackList[V]{lastSidetrack.edge, current.previousSidetracks}
+
+			if !yield(&sp.Path[V, W]{Edges: tree.walk(source, sidetracks), Weight: current.weight}) {
+				return
+			}
+
+			push := func(next *candidate[V, W]) {
+				candidates.Push(next, next.weight)
+			}
+
+			// heap edges of path graph replace the last sidetrack
+			// with the next best one
+
+			if current.index == 0 {
+				for _, child := range []*heapNode[V, W]{current.node.left, current.node.right} {
+					if child != nil {
+						push(&candidate[V, W]{
+							node:               child,
+							weight:             current.weight - current.node.key + child.key,
+							previousSidetracks: current.previousSidetracks,
+						})
+					}
+				}
+			}
+
+			if current.index+1 < len(currentSidetracks) {
+				push(&candidate[V, W]{
+					node:               current.node,
+					index:              current.index + 1,
+					weight:             current.weight - lastSidetrack.delta + currentSidetracks[current.index+1].delta,
+					previousSidetracks: current.previousSidetracks,
+				})
+			}
+
+			// cross edge of path graph appends a sidetrack after the last one
+
+			if root := heapOf(lastSidetrack.edge.Target()); root != nil {
+				push(&candidate[V, W]{
+					node:               root,
+					weight:             current.weight + root.key,
+					previousSidetracks: sidetracks,
+				})
+			}
+		}
+	}, nil
+}
+
+// newShortestPathTree runs Dijkstra's algorithm from target over predecessors.
+func newShortestPathTree[V graph.Vertex, W sp.Weight](
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	target V,
+) *shortestPathTree[V, W] {
+	tree := &shortestPathTree[V, W]{
+		target:           target,
+		vertexToDistance: map[V]W{target: 0},
+		vertexToNext:     make(map[V]V),
+	}
+	settledVertices := make(map[V]struct{})
+	vertexQueue := priorityqueue.NewBinaryHeap[V, W]()
+
+	vertexQueue.Push(target, 0)
+
+	for !vertexQueue.IsEmpty() {
+		v, vDistance := vertexQueue.Pop()
+		settledVertices[v] = struct{}{}
+
+		for u := range digraph.PredecessorsSeq(v) {
+			if _, uIsSettled := settledVertices[u]; uIsSettled {
+				continue
+			}
+
+			uDistance, uIsReached := tree.vertexToDistance[u]
+			uvWeight := digraph.Weight[graph.NewEdge(u, v)]
+
+			if !uIsReached || vDistance+uvWeight < uDistance {
+				tree.vertexToDistance[u] = vDistance + uvWeight
+				tree.vertexToNext[u] = v
+				vertexQueue.Push(u, vDistance+uvWeight)
+			}
+		}
+	}
+
+	return tree
+}
+
+// walk builds the walk from source that follows the tree and takes
+// sidetracks in order.
+func (tree *shortestPathTree[V, W]) walk(source V, sidetracks *sidetrackList[V]) []graph.Edge[V] {
+	orderedSidetracks := make([]graph.Edge[V], 0)
+
+	for ; sidetracks != nil; sidetracks = sidetracks.previous {
+		orderedSidetracks = append(orderedSidetracks, sidetracks.edge)
+	}
+
+	slices.Reverse(orderedSidetracks)
+
+	edges := make([]graph.Edge[V], 0)
+	u := source
+
+	for _, sidetrack := range orderedSidetracks {
+		for ; u != sidetrack.Source(); u = tree.vertexToNext[u] {
+			edges = append(edges, graph.NewEdge(u, tree.vertexToNext[u]))
+		}
+
+		edges = append(edges, sidetrack)
+		u = sidetrack.Target()
+	}
+
+	for ; u != tree.target; u = tree.vertexToNext[u] {
+		edges = append(edges, graph.NewEdge(u, tree.vertexToNext[u]))
+	}
+
+	return edges
+}
+
+// sidetracks returns sidetracks of every vertex that reaches target sorted
+// by delta, only sidetracks to vertices that reach target are considered.
+func sidetracks[V graph.Vertex, W sp.Weight](
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	tree *shortestPathTree[V, W],
+) map[V][]sidetrack[V, W] {
+	vertexToSidetracks := make(map[V][]sidetrack[V, W])
+
+	for u, uDistance := range tree.vertexToDistance {
+		uNext, uHasNext := tree.vertexToNext[u]
+
+		for v := range digraph.SuccessorsSeq(u) {
+			vDistance, vReachesTarget := tree.vertexToDistance[v]
+
+			if !vReachesTarget || (uHasNext && v == uNext) {
+				continue
+			}
+
+			uv := graph.NewEdge(u, v)
+
+			vertexToSidetracks[u] = append(vertexToSidetracks[u], sidetrack[V, W]{
+				edge:  uv,
+				delta: digraph.Weight[uv] + vDistance - uDistance,
+			})
+		}
+
+		slices.SortFunc(vertexToSidetracks[u], func(a, b sidetrack[V, W]) int {
+			return cmp.Compare(a.delta, b.delta)
+		})
+	}
+
+	return vertexToSidetracks
+}
+
+// treeHeap returns H_T(vertex), which is H_T of the next vertex on the tree
+// path with vertex inserted if it has sidetracks, and memoizes it.
+func treeHeap[V graph.Vertex, W sp.Weight](
+	vertex V,
+	tree *shortestPathTree[V, W],
+	vertexToSidetracks map[V][]sidetrack[V, W],
+	vertexToHeap map[V]*heapNode[V, W],
+) *heapNode[V, W] {
+	// the tree path may be long, so heaps are built iteratively
+	// from the first memoized vertex back to vertex
+	path := make([]V, 0)
+
+	for u := vertex; ; u = tree.vertexToNext[u] {
+		if _, isMemoized := vertexToHeap[u]; isMemoized {
+			break
+		}
+
+		path = append(path, u)
+
+		if u == tree.target {
+			break
+		}
+	}
+
+	for _, u := range slices.Backward(path) {
+		var heap *heapNode[V, W]
+
+		if u != tree.target {
+			heap = vertexToHeap[tree.vertexToNext[u]]
+		}
+
+		if uSidetracks := vertexToSidetracks[u]; len(uSidetracks) > 0 {
+			heap = merge(heap, &heapNode[V, W]{vertex: u, key: uSidetracks[0].delta, rank: 1})
+		}
+
+		vertexToHeap[u] = heap
+	}
+
+	return vertexToHeap[vertex]
+}
+
+// merge merges persistent leftist heaps a and b copying only nodes on the
+// right spine, so a and b stay unchanged.
+func merge[V graph.Vertex, W sp.Weight](a, b *heapNode[V, W]) *heapNode[V, W] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if b.key < a.key {
+		a, b = b, a
+	}
+
+	merged := *a
+	merged.right = merge(a.right, b)
+
+	if rank(merged.left) < rank(merged.right) {
+		merged.left, merged.right = merged.right, merged.left
+	}
+
+	merged.rank = rank(merged.right) + 1
+
+	return &merged
+}
+
+func rank[V graph.Vertex, W sp.Weight](node *heapNode[V, W]) int {
+	if node == nil {
+		return 0
+	}
+
+	return node.rank
+}
+
+func assertPreconditions[V graph.Vertex, W sp.Weight](
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	source V,
+	target V,
+) {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+	if digraph.SimpleDigraph == nil {
+		panic("digraph.SimpleDigraph == nil")
+	}
+	if digraph.Weight == nil {
+		panic("digraph.Weight == nil")
+	}
+
+	vertices := digraph.Vertices()
+
+	if !vertices.Contains(source) {
+		panic("source is not present in digraph")
+	}
+	if !vertices.Contains(target) {
+		panic("target is not present in digraph")
+	}
+}
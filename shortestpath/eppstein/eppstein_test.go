@@ -0,0 +1,127 @@
+package eppstein
+
+import (
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/generate"
+	"goraph/graph/traverse"
+	"goraph/internal/priorityqueue"
+	sp "goraph/shortestpath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEppstein_Compute(t *testing.T) {
+	rng := generate.NewRand(1)
+	k := 30
+
+	for range 20 {
+		simpleDigraph, err := generate.ErdosRenyiGnp(8, 0.3, rng)
+
+		assert.Nil(t, err)
+
+		digraph := &sp.WeightedSimpleDigraph[int, int]{SimpleDigraph: simpleDigraph, Weight: make(sp.Weights[int, int])}
+
+		for edge := range simpleDigraph.AllEdges() {
+			digraph.Weight[edge] = rng.IntN(10) + 1
+		}
+
+		expectedWeights := walkWeights(digraph, 1, 8, k)
+
+		paths, err := NewEppstein[int, int]().Compute(digraph, 1, 8)
+
+		assert.Nil(t, err)
+
+		weights := make([]int, 0)
+		knownWalks := make(map[string]struct{})
+
+		for path := range paths {
+			u := 1
+			pathWeight := 0
+
+			for _, edge := range path.Edges {
+				assert.Equal(t, u, edge.Source())
+				assert.NotNil(t, simpleDigraph.Edge(edge.Source(), edge.Target()))
+
+				u = edge.Target()
+				pathWeight += digraph.Weight[edge]
+			}
+
+			assert.Equal(t, 8, u)
+			assert.Equal(t, path.Weight, pathWeight)
+
+			key := fmt.Sprint(path.Edges)
+
+			assert.NotContains(t, knownWalks, key)
+
+			knownWalks[key] = struct{}{}
+			weights = append(weights, path.Weight)
+
+			if len(weights) == k {
+				break
+			}
+		}
+
+		assert.Equal(t, expectedWeights, weights)
+	}
+}
+
+func TestEppstein_Compute_Cycle(t *testing.T) {
+	simpleDigraph, err := generate.Complete(2)
+
+	assert.Nil(t, err)
+
+	digraph := &sp.WeightedSimpleDigraph[int, int]{
+		SimpleDigraph: simpleDigraph,
+		Weight:        sp.Weights[int, int]{graph.NewEdge(1, 2): 1, graph.NewEdge(2, 1): 2},
+	}
+
+	paths, _ := NewEppstein[int, int]().Compute(digraph, 1, 2)
+	weights := make([]int, 0)
+
+	for path := range paths {
+		weights = append(weights, path.Weight)
+
+		if len(weights) == 4 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 4, 7, 10}, weights)
+}
+
+// walkWeights returns weights of k shortest walks by best-first search over
+// all walks from source, which works because all weights are positive.
+// Walks are only extended by vertices that reach target, so it terminates.
+func walkWeights(digraph *sp.WeightedSimpleDigraph[int, int], source, target int, k int) []int {
+	type walk struct {
+		vertex int
+		weight int
+	}
+
+	reachesTarget := traverse.BreadthFirstSearch(digraph, []int{target}, traverse.Visitor[int]{}, traverse.Options{Direction: traverse.In})
+	weights := make([]int, 0)
+	walks := priorityqueue.NewBinaryHeap[*walk, int]()
+
+	walks.Push(&walk{source, 0}, 0)
+
+	for !walks.IsEmpty() && len(weights) < k {
+		current, _ := walks.Pop()
+
+		if current.vertex == target {
+			weights = append(weights, current.weight)
+		}
+
+		for v := range digraph.SuccessorsSeq(current.vertex) {
+			if !reachesTarget.IsReached(v) {
+				continue
+			}
+
+			next := &walk{v, current.weight + digraph.Weight[graph.NewEdge(current.vertex, v)]}
+			walks.Push(next, next.weight)
+		}
+	}
+
+	return weights
+}
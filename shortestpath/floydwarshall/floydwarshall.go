@@ -0,0 +1,137 @@
+package floydwarshall
+
+import (
+	"goraph/graph"
+	sp "goraph/shortestpath"
+	"slices"
+)
+
+type floydWarshall[V graph.Vertex, W sp.Weight] struct{}
+
+var _ sp.AllPairs[struct{}, int] = (*floydWarshall[struct{}, int])(nil)
+
+// NewFloydWarshall creates a Floyd-Warshall algorithm implementation of
+// shortestpath.AllPairs, which works in O(n^3) time and is suited for
+// dense graphs.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Floyd%E2%80%93Warshall_algorithm
+func NewFloydWarshall[V graph.Vertex, W sp.Weight]() sp.AllPairs[V, W] {
+	return floydWarshall[V, W]{}
+}
+
+func (algorithm floydWarshall[V, W]) Compute(
+	digraph *sp.WeightedSimpleDigraph[V, W],
+) (*sp.DistanceMatrix[V, W], error) {
+	assertPreconditions(digraph)
+
+	matrix := sp.NewDistanceMatrix[V, W](graph.NewIndexer(digraph.AllVertices()))
+	indexer := matrix.Indexer
+	n := indexer.Len()
+
+	for edge := range digraph.AllEdges() {
+		i, _ := indexer.Index(edge.Source())
+		j, _ := indexer.Index(edge.Target())
+
+		matrix.Distances[i*n+j] = digraph.Weight[edge]
+		matrix.Predecessors[i*n+j] = int32(i)
+	}
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if !matrix.IsReachable(i, k) {
+				continue
+			}
+
+			ikDistance := matrix.Distances[i*n+k]
+
+			for j := 0; j < n; j++ {
+				if !matrix.IsReachable(k, j) {
+					continue
+				}
+
+				ijDistance := ikDistance + matrix.Distances[k*n+j]
+
+				if i == j && ijDistance < 0 {
+					return nil, negativeCycle(digraph, matrix, i, k)
+				}
+
+				if i != j && (!matrix.IsReachable(i, j) || ijDistance < matrix.Distances[i*n+j]) {
+					matrix.Distances[i*n+j] = ijDistance
+					matrix.Predecessors[i*n+j] = matrix.Predecessors[k*n+j]
+				}
+			}
+		}
+	}
+
+	return matrix, nil
+}
+
+// negativeCycle finds the negative cycle in the closed walk from vertex with
+// index i to vertex with index k and back along the shortest paths of matrix.
+//
+// The walk is split into simple cycles and the lightest of them is returned,
+// its weight is negative because the weights of the cycles sum up to the
+// negative weight of the walk.
+func negativeCycle[V graph.Vertex, W sp.Weight](
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	matrix *sp.DistanceMatrix[V, W],
+	i, k int,
+) *sp.NegativeCycleError[V] {
+	u := matrix.Indexer.Vertex(i)
+	v := matrix.Indexer.Vertex(k)
+	walk := append(matrix.Path(u, v), matrix.Path(v, u)...)
+
+	var lightestCycle []graph.Edge[V]
+	var lightestCycleWeight W
+
+	// stack holds the simple path walked so far, position holds the index
+	// in stack of the edge leaving the vertex
+	stack := make([]graph.Edge[V], 0, len(walk))
+	position := map[V]int{u: 0}
+
+	for _, edge := range walk {
+		stack = append(stack, edge)
+
+		start, isOnStack := position[edge.Target()]
+
+		if !isOnStack {
+			position[edge.Target()] = len(stack)
+
+			continue
+		}
+
+		cycle := stack[start:]
+		var cycleWeight W
+
+		for _, cycleEdge := range cycle {
+			cycleWeight += digraph.Weight[cycleEdge]
+		}
+
+		if lightestCycle == nil || cycleWeight < lightestCycleWeight {
+			lightestCycle = slices.Clone(cycle)
+			lightestCycleWeight = cycleWeight
+		}
+
+		for _, cycleEdge := range cycle[1:] {
+			delete(position, cycleEdge.Source())
+		}
+
+		stack = stack[:start]
+	}
+
+	return &sp.NegativeCycleError[V]{Cycle: lightestCycle}
+}
+
+func assertPreconditions[V graph.Vertex, W sp.Weight](digraph *sp.WeightedSimpleDigraph[V, W]) {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+	if digraph.SimpleDigraph == nil {
+		panic("digraph.SimpleDigraph == nil")
+	}
+	if digraph.Weight == nil {
+		panic("digraph.Weight == nil")
+	}
+}
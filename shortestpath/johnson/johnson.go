@@ -0,0 +1,121 @@
+package johnson
+
+import (
+	"goraph/graph"
+	sp "goraph/shortestpath"
+	"goraph/shortestpath/bellmanford"
+	"goraph/shortestpath/dijkstra"
+	"runtime"
+	"sync"
+)
+
+type johnson[V graph.Vertex, W sp.Weight] struct{}
+
+var _ sp.AllPairs[struct{}, int] = (*johnson[struct{}, int])(nil)
+
+// NewJohnson creates a Johnson's algorithm implementation of
+// shortestpath.AllPairs, which reweights edges using Bellman-Ford
+// potentials and then runs Dijkstra's algorithm from every vertex in
+// O(nm log n) time, so it is suited for sparse graphs.
+//
+// Dijkstra's algorithm runs in runtime.GOMAXPROCS(0) goroutines. Reweighted
+// float weights that are negative only because of rounding are treated
+// as 0.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Johnson%27s_algorithm
+func NewJohnson[V graph.Vertex, W sp.Weight]() sp.AllPairs[V, W] {
+	return johnson[V, W]{}
+}
+
+func (algorithm johnson[V, W]) Compute(
+	digraph *sp.WeightedSimpleDigraph[V, W],
+) (*sp.DistanceMatrix[V, W], error) {
+	assertPreconditions(digraph)
+
+	potentials, err := bellmanford.Potentials(digraph)
+
+	if err != nil {
+		return nil, err
+	}
+
+	reweightedDigraph := &sp.WeightedSimpleDigraph[V, W]{
+		SimpleDigraph: digraph.SimpleDigraph,
+		Weight:        make(sp.Weights[V, W], digraph.Size()),
+	}
+
+	for edge := range digraph.AllEdges() {
+		reweightedWeight := digraph.Weight[edge] + potentials[edge.Source()] - potentials[edge.Target()]
+		reweightedDigraph.Weight[edge] = max(reweightedWeight, 0)
+	}
+
+	matrix := sp.NewDistanceMatrix[V, W](graph.NewIndexer(digraph.AllVertices()))
+	indexer := matrix.Indexer
+	n := indexer.Len()
+
+	sources := make(chan int)
+	errs := make(chan error, n)
+	var waitGroup sync.WaitGroup
+
+	for range min(runtime.GOMAXPROCS(0), max(n, 1)) {
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+
+			singleSource := dijkstra.NewDijkstra[V, W]()
+
+			// every goroutine writes only rows of its sources
+			for i := range sources {
+				source := indexer.Vertex(i)
+				paths, err := singleSource.Compute(reweightedDigraph, source)
+
+				if err != nil {
+					errs <- err
+
+					continue
+				}
+
+				for vertex, distance := range paths.Distances {
+					j, _ := indexer.Index(vertex)
+
+					matrix.Distances[i*n+j] = distance - potentials[source] + potentials[vertex]
+				}
+
+				for vertex, predecessor := range paths.Predecessors {
+					j, _ := indexer.Index(vertex)
+					k, _ := indexer.Index(predecessor)
+
+					matrix.Predecessors[i*n+j] = int32(k)
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		sources <- i
+	}
+
+	close(sources)
+	waitGroup.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+
+	return matrix, nil
+}
+
+func assertPreconditions[V graph.Vertex, W sp.Weight](digraph *sp.WeightedSimpleDigraph[V, W]) {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+	if digraph.SimpleDigraph == nil {
+		panic("digraph.SimpleDigraph == nil")
+	}
+	if digraph.Weight == nil {
+		panic("digraph.Weight == nil")
+	}
+}
@@ -0,0 +1,125 @@
+package johnson
+
+import (
+	"goraph/graph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/graph/generate"
+	sp "goraph/shortestpath"
+	"goraph/shortestpath/bellmanford"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJohnson_Compute(t *testing.T) {
+	weight := sp.Weights[int, int]{
+		graph.NewEdge(1, 3): -2,
+		graph.NewEdge(3, 4): 2,
+		graph.NewEdge(4, 2): -1,
+		graph.NewEdge(2, 1): 4,
+		graph.NewEdge(2, 3): 3,
+	}
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4, 5),
+		mapset.NewFromElements(graph.NewEdge(1, 3), graph.NewEdge(3, 4), graph.NewEdge(4, 2), graph.NewEdge(2, 1), graph.NewEdge(2, 3)),
+	)
+	digraph := &sp.WeightedSimpleDigraph[int, int]{SimpleDigraph: simpleDigraph, Weight: weight}
+
+	matrix, err := NewJohnson[int, int]().Compute(digraph)
+
+	assert.Nil(t, err)
+
+	distance, isReachable := matrix.Distance(2, 4)
+
+	assert.True(t, isReachable)
+	assert.Equal(t, 4, distance)
+	assert.Equal(
+		t,
+		[]graph.Edge[int]{graph.NewEdge(2, 1), graph.NewEdge(1, 3), graph.NewEdge(3, 4)},
+		matrix.Path(2, 4),
+	)
+
+	distance, isReachable = matrix.Distance(1, 2)
+
+	assert.True(t, isReachable)
+	assert.Equal(t, -1, distance)
+	assert.Empty(t, matrix.Path(3, 3))
+
+	_, isReachable = matrix.Distance(1, 5)
+
+	assert.False(t, isReachable)
+	assert.Nil(t, matrix.Path(1, 5))
+}
+
+func TestJohnson_Compute_NegativeCycle(t *testing.T) {
+	weight := sp.Weights[int, int]{
+		graph.NewEdge(1, 2): 1,
+		graph.NewEdge(2, 3): -1,
+		graph.NewEdge(3, 1): -1,
+	}
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3),
+		mapset.NewFromElements(graph.NewEdge(1, 2), graph.NewEdge(2, 3), graph.NewEdge(3, 1)),
+	)
+
+	matrix, err := NewJohnson[int, int]().Compute(
+		&sp.WeightedSimpleDigraph[int, int]{SimpleDigraph: simpleDigraph, Weight: weight},
+	)
+
+	assert.Nil(t, matrix)
+
+	var negativeCycleError *sp.NegativeCycleError[int]
+
+	assert.ErrorAs(t, err, &negativeCycleError)
+	assert.Len(t, negativeCycleError.Cycle, 3)
+}
+
+func TestJohnson_Compute_Random(t *testing.T) {
+	rng := generate.NewRand(1)
+
+	for range 10 {
+		simpleDigraph, err := generate.ErdosRenyiGnp(25, 0.15, rng)
+
+		assert.Nil(t, err)
+
+		// weights c + p(u) - p(v) with c >= 0 make no negative cycles
+		potential := make(map[int]int)
+
+		for vertex := range simpleDigraph.AllVertices() {
+			potential[vertex] = rng.IntN(20)
+		}
+
+		weight := make(sp.Weights[int, int])
+
+		for edge := range simpleDigraph.AllEdges() {
+			weight[edge] = rng.IntN(10) + potential[edge.Source()] - potential[edge.Target()]
+		}
+
+		digraph := &sp.WeightedSimpleDigraph[int, int]{SimpleDigraph: simpleDigraph, Weight: weight}
+
+		matrix, err := NewJohnson[int, int]().Compute(digraph)
+
+		assert.Nil(t, err)
+
+		for u := range simpleDigraph.AllVertices() {
+			paths, _ := bellmanford.NewBellmanFord[int, int]().Compute(digraph, u)
+
+			for v := range simpleDigraph.AllVertices() {
+				expectedDistance, expectedIsReachable := paths.Distance(v)
+				distance, isReachable := matrix.Distance(u, v)
+
+				assert.Equal(t, expectedIsReachable, isReachable)
+				assert.Equal(t, expectedDistance, distance)
+
+				pathWeight := 0
+
+				for _, edge := range matrix.Path(u, v) {
+					pathWeight += weight[edge]
+				}
+
+				assert.Equal(t, expectedDistance, pathWeight)
+			}
+		}
+	}
+}
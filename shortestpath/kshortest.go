@@ -0,0 +1,26 @@
+package shortestpath
+
+import (
+	"goraph/graph"
+	"iter"
+)
+
+// KShortest interface represents a k shortest paths algorithm with single
+// method that lazily enumerates paths from source to target.
+//
+// No implementation can mutate WeightedSimpleDigraph in any way.
+//
+// https://en.wikipedia.org/wiki/K_shortest_path_routing
+type KShortest[V graph.Vertex, W Weight] interface {
+	// Compute returns an iter.Seq over paths from source to target in this
+	// WeightedSimpleDigraph in order of non-decreasing Path.Weight, so the
+	// caller takes as many paths as needed and stops. Paths are computed
+	// during iteration, so WeightedSimpleDigraph must not change until then.
+	//
+	// Path.SettledVertices of yielded paths is 0.
+	//
+	// It returns an error if some edge has negative weight.
+	//
+	// It panics if digraph is nil or source or target is not present in it.
+	Compute(digraph *WeightedSimpleDigraph[V, W], source V, target V) (iter.Seq[*Path[V, W]], error)
+}
@@ -0,0 +1,17 @@
+package shortestpath
+
+import (
+	"fmt"
+	"goraph/graph"
+)
+
+// NegativeCycleError is returned when shortest paths are not defined
+// because of a cycle with negative total weight.
+type NegativeCycleError[V graph.Vertex] struct {
+	// Cycle holds edges of the negative cycle in order.
+	Cycle []graph.Edge[V]
+}
+
+func (err *NegativeCycleError[V]) Error() string {
+	return fmt.Sprintf("negative cycle %+v", err.Cycle)
+}
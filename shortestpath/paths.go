@@ -0,0 +1,58 @@
+package shortestpath
+
+import (
+	"goraph/graph"
+	"slices"
+)
+
+// Paths holds paths from Source to all vertices reachable from it.
+type Paths[V graph.Vertex, W Weight] struct {
+	Source V
+
+	// Distances maps every reachable vertex to the weight of its path.
+	Distances map[V]W
+
+	// Predecessors maps every reachable vertex except Source to its
+	// predecessor on its path.
+	Predecessors map[V]V
+}
+
+// NewPaths creates Paths that contain only the empty path to source.
+func NewPaths[V graph.Vertex, W Weight](source V) *Paths[V, W] {
+	return &Paths[V, W]{
+		Source:       source,
+		Distances:    map[V]W{source: 0},
+		Predecessors: make(map[V]V),
+	}
+}
+
+// Distance returns the weight of the path to vertex and true iff
+// vertex is reachable from Source.
+func (paths *Paths[V, W]) Distance(vertex V) (W, bool) {
+	distance, isReachable := paths.Distances[vertex]
+
+	return distance, isReachable
+}
+
+// PathTo returns edges of the path from Source to vertex, it is empty
+// for Source and nil if vertex is not reachable from Source.
+func (paths *Paths[V, W]) PathTo(vertex V) []graph.Edge[V] {
+	if _, isReachable := paths.Distances[vertex]; !isReachable {
+		return nil
+	}
+
+	path := make([]graph.Edge[V], 0)
+	v := vertex
+	u, uIsPresent := paths.Predecessors[v]
+
+	for uIsPresent {
+		path = append(path, graph.NewEdge(u, v))
+
+		v = u
+		u, uIsPresent = paths.Predecessors[v]
+	}
+
+	slices.Reverse(path)
+
+	return path
+}
@@ -0,0 +1,36 @@
+package shortestpath
+
+import "goraph/graph"
+
+// PointToPoint interface represents a point-to-point shortest path algorithm
+// with single method that computes Path from source to target.
+//
+// No implementation can mutate WeightedSimpleDigraph in any way.
+//
+// https://en.wikipedia.org/wiki/Shortest_path_problem#Single-pair_shortest_path
+type PointToPoint[V graph.Vertex, W Weight] interface {
+	// Compute computes the shortest Path from source to target in this
+	// WeightedSimpleDigraph.
+	//
+	// It panics if digraph is nil or source or target is not present in it.
+	Compute(digraph *WeightedSimpleDigraph[V, W], source V, target V) (*Path[V, W], error)
+}
+
+// Path is a result of PointToPoint algorithm.
+type Path[V graph.Vertex, W Weight] struct {
+	// Edges of the path in order, it is empty if source == target and nil
+	// if target is not reachable from source.
+	Edges []graph.Edge[V]
+
+	// Weight is the total weight of Edges.
+	Weight W
+
+	// SettledVertices is the amount of vertices settled (popped from the
+	// priority queue) by the search, which allows to compare algorithms.
+	SettledVertices int
+}
+
+// IsFound returns true iff target is reachable from source.
+func (path *Path[V, W]) IsFound() bool {
+	return path.Edges != nil
+}
@@ -0,0 +1,17 @@
+package shortestpath
+
+import "goraph/graph"
+
+// SingleSource interface represents a single-source path algorithm with
+// single method that computes Paths from source to all vertices
+// reachable from it in WeightedSimpleDigraph.
+//
+// No implementation can mutate WeightedSimpleDigraph in any way.
+//
+// https://en.wikipedia.org/wiki/Shortest_path_problem#Single-source_shortest_paths
+type SingleSource[V graph.Vertex, W Weight] interface {
+	// Compute computes Paths from source in this WeightedSimpleDigraph.
+	//
+	// It panics if digraph is nil or source is not present in it.
+	Compute(digraph *WeightedSimpleDigraph[V, W], source V) (*Paths[V, W], error)
+}
@@ -0,0 +1,15 @@
+package shortestpath
+
+import "goraph/graph"
+
+// Weight is a constraint of numeric edge weights.
+//
+// Sums of weights are not checked for overflow.
+type Weight interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Weights maps graph.Edge to its weight.
+type Weights[V graph.Vertex, W Weight] map[graph.Edge[V]]W
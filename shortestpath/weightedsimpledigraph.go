@@ -0,0 +1,14 @@
+package shortestpath
+
+import (
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+)
+
+// WeightedSimpleDigraph https://en.wikipedia.org/wiki/Glossary_of_graph_theory#weighted_graph
+type WeightedSimpleDigraph[V graph.Vertex, W Weight] struct {
+	simpledigraph.SimpleDigraph[V]
+
+	// Weight must have a mapping for every edge in simpledigraph.SimpleDigraph.
+	Weight Weights[V, W]
+}
@@ -0,0 +1,157 @@
+package yen
+
+import (
+	"fmt"
+	"goraph/graph"
+	"goraph/graph/digraph/simpledigraph"
+	"goraph/internal/priorityqueue"
+	sp "goraph/shortestpath"
+	"goraph/shortestpath/astar"
+	"iter"
+	"slices"
+)
+
+type yen[V graph.Vertex, W sp.Weight] struct{}
+
+var _ sp.KShortest[struct{}, int] = (*yen[struct{}, int])(nil)
+
+// NewYen creates a Yen's algorithm implementation of shortestpath.KShortest,
+// which enumerates simple (loopless) paths.
+//
+// Every spur path is searched in a simpledigraph.FilterEdges view without
+// removed edges and vertices, so the digraph is never copied.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Yen%27s_algorithm
+func NewYen[V graph.Vertex, W sp.Weight]() sp.KShortest[V, W] {
+	return yen[V, W]{}
+}
+
+func (algorithm yen[V, W]) Compute(
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	source V,
+	target V,
+) (iter.Seq[*sp.Path[V, W]], error) {
+	assertPreconditions(digraph, source, target)
+
+	for edge := range digraph.AllEdges() {
+		if digraph.Weight[edge] < 0 {
+			return nil, fmt.Errorf("edge %+v has negative weight", edge)
+		}
+	}
+
+	dijkstra := astar.NewAStar(func(V) W { return 0 })
+
+	return func(yield func(*sp.Path[V, W]) bool) {
+		firstPath, _ := dijkstra.Compute(digraph, source, target)
+
+		if !firstPath.IsFound() {
+			return
+		}
+
+		shortestPaths := []*sp.Path[V, W]{newPath(firstPath.Edges, firstPath.Weight)}
+		candidatePaths := priorityqueue.NewBinaryHeap[*sp.Path[V, W], W]()
+		// every path that was ever a candidate, so that none is pushed twice
+		knownPaths := []*sp.Path[V, W]{shortestPaths[0]}
+
+		for {
+			lastPath := shortestPaths[len(shortestPaths)-1]
+
+			if !yield(lastPath) {
+				return
+			}
+
+			var rootPathWeight W
+
+			for i, spurEdge := range lastPath.Edges {
+				spurVertex := spurEdge.Source()
+				rootPath := lastPath.Edges[:i]
+
+				// edges that continue already found paths with the same root path
+				removedEdges := make(map[graph.Edge[V]]struct{})
+
+				for _, path := range shortestPaths {
+					if len(path.Edges) > i && slices.Equal(path.Edges[:i], rootPath) {
+						removedEdges[path.Edges[i]] = struct{}{}
+					}
+				}
+
+				// vertices of root path, so that paths stay simple
+				removedVertices := make(map[V]struct{})
+
+				for _, edge := range rootPath {
+					removedVertices[edge.Source()] = struct{}{}
+				}
+
+				spurDigraph := &sp.WeightedSimpleDigraph[V, W]{
+					SimpleDigraph: simpledigraph.FilterEdges(digraph.SimpleDigraph, func(edge graph.Edge[V]) bool {
+						_, edgeIsRemoved := removedEdges[edge]
+						_, sourceIsRemoved := removedVertices[edge.Source()]
+						_, targetIsRemoved := removedVertices[edge.Target()]
+
+						return !edgeIsRemoved && !sourceIsRemoved && !targetIsRemoved
+					}),
+					Weight: digraph.Weight,
+				}
+
+				spurPath, _ := dijkstra.Compute(spurDigraph, spurVertex, target)
+
+				if spurPath.IsFound() {
+					edges := slices.Concat(rootPath, spurPath.Edges)
+
+					// a later shortest path with the same root path may find
+					// the same candidate again
+					isKnown := slices.ContainsFunc(knownPaths, func(path *sp.Path[V, W]) bool {
+						return slices.Equal(path.Edges, edges)
+					})
+
+					if !isKnown {
+						candidatePath := newPath(edges, rootPathWeight+spurPath.Weight)
+
+						knownPaths = append(knownPaths, candidatePath)
+						candidatePaths.Push(candidatePath, candidatePath.Weight)
+					}
+				}
+
+				rootPathWeight += digraph.Weight[spurEdge]
+			}
+
+			if candidatePaths.IsEmpty() {
+				return
+			}
+
+			nextPath, _ := candidatePaths.Pop()
+			shortestPaths = append(shortestPaths, nextPath)
+		}
+	}, nil
+}
+
+func newPath[V graph.Vertex, W sp.Weight](edges []graph.Edge[V], weight W) *sp.Path[V, W] {
+	return &sp.Path[V, W]{Edges: edges, Weight: weight}
+}
+
+func assertPreconditions[V graph.Vertex, W sp.Weight](
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	source V,
+	target V,
+) {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+	if digraph.SimpleDigraph == nil {
+		panic("digraph.SimpleDigraph == nil")
+	}
+	if digraph.Weight == nil {
+		panic("digraph.Weight == nil")
+	}
+
+	vertices := digraph.Vertices()
+
+	if !vertices.Contains(source) {
+		panic("source is not present in digraph")
+	}
+	if !vertices.Contains(target) {
+		panic("target is not present in digraph")
+	}
+}
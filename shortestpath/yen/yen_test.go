@@ -0,0 +1,148 @@
+package yen
+
+import (
+	"cmp"
+	"goraph/graph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/graph/generate"
+	sp "goraph/shortestpath"
+	"slices"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYen_Compute(t *testing.T) {
+	// https://en.wikipedia.org/wiki/Yen%27s_algorithm#Example
+	weight := sp.Weights[string, int]{
+		graph.NewEdge("C", "D"): 3,
+		graph.NewEdge("C", "E"): 2,
+		graph.NewEdge("D", "F"): 4,
+		graph.NewEdge("E", "D"): 1,
+		graph.NewEdge("E", "F"): 2,
+		graph.NewEdge("E", "G"): 3,
+		graph.NewEdge("F", "G"): 2,
+		graph.NewEdge("F", "H"): 1,
+		graph.NewEdge("G", "H"): 2,
+	}
+	edges := mapset.New[graph.Edge[string]]()
+
+	for edge := range weight {
+		edges.Add(edge)
+	}
+
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(mapset.NewFromElements("C", "D", "E", "F", "G", "H"), edges)
+	digraph := &sp.WeightedSimpleDigraph[string, int]{SimpleDigraph: simpleDigraph, Weight: weight}
+
+	paths, err := NewYen[string, int]().Compute(digraph, "C", "H")
+
+	assert.Nil(t, err)
+
+	weights := make([]int, 0)
+
+	for path := range paths {
+		if len(weights) == 0 {
+			assert.Equal(
+				t,
+				[]graph.Edge[string]{graph.NewEdge("C", "E"), graph.NewEdge("E", "F"), graph.NewEdge("F", "H")},
+				path.Edges,
+			)
+		}
+
+		weights = append(weights, path.Weight)
+
+		if len(weights) == 3 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{5, 7, 8}, weights)
+}
+
+func TestYen_Compute_AllSimplePaths(t *testing.T) {
+	rng := generate.NewRand(1)
+
+	for range 20 {
+		simpleDigraph, err := generate.ErdosRenyiGnp(7, 0.4, rng)
+
+		assert.Nil(t, err)
+
+		digraph := &sp.WeightedSimpleDigraph[int, int]{SimpleDigraph: simpleDigraph, Weight: make(sp.Weights[int, int])}
+
+		for edge := range simpleDigraph.AllEdges() {
+			digraph.Weight[edge] = rng.IntN(10) + 1
+		}
+
+		expectedWeights := simplePathWeights(digraph, 1, 7)
+		slices.Sort(expectedWeights)
+
+		paths, _ := NewYen[int, int]().Compute(digraph, 1, 7)
+		weights := make([]int, 0)
+		knownPaths := make([][]graph.Edge[int], 0)
+
+		for path := range paths {
+			assert.True(t, isSimplePath(path.Edges, 1, 7))
+
+			pathWeight := 0
+
+			for _, edge := range path.Edges {
+				pathWeight += digraph.Weight[edge]
+			}
+
+			assert.Equal(t, path.Weight, pathWeight)
+			for _, knownPath := range knownPaths {
+				assert.NotEqual(t, knownPath, path.Edges)
+			}
+
+			knownPaths = append(knownPaths, path.Edges)
+			weights = append(weights, path.Weight)
+		}
+
+		assert.True(t, slices.IsSortedFunc(weights, cmp.Compare))
+		assert.Equal(t, expectedWeights, weights)
+	}
+}
+
+func simplePathWeights(digraph *sp.WeightedSimpleDigraph[int, int], source, target int) []int {
+	weights := make([]int, 0)
+	isOnPath := map[int]bool{source: true}
+
+	var search func(u int, weight int)
+
+	search = func(u int, weight int) {
+		if u == target {
+			weights = append(weights, weight)
+
+			return
+		}
+
+		for v := range digraph.SuccessorsSeq(u) {
+			if !isOnPath[v] {
+				isOnPath[v] = true
+				search(v, weight+digraph.Weight[graph.NewEdge(u, v)])
+				isOnPath[v] = false
+			}
+		}
+	}
+
+	search(source, 0)
+
+	return weights
+}
+
+func isSimplePath(edges []graph.Edge[int], source, target int) bool {
+	visitedVertices := map[int]bool{source: true}
+	u := source
+
+	for _, edge := range edges {
+		if edge.Source() != u || visitedVertices[edge.Target()] {
+			return false
+		}
+
+		u = edge.Target()
+		visitedVertices[u] = true
+	}
+
+	return u == target
+}
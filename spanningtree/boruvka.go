@@ -0,0 +1,124 @@
+package spanningtree
+
+import (
+	"goraph/connectivity"
+	"goraph/graph"
+	sp "goraph/shortestpath"
+	"runtime"
+	"sync"
+)
+
+type boruvka[V graph.Vertex, W sp.Weight] struct {
+	objective Objective
+}
+
+var _ SpanningForest[struct{}, int] = (*boruvka[struct{}, int])(nil)
+
+// NewBoruvka creates a Borůvka's algorithm implementation of SpanningForest,
+// which in every round adds the best edge leaving every component, so
+// the amount of components at least halves.
+//
+// Best edges of a round are found in parallel by GOMAXPROCS goroutines,
+// each scanning its own part of edges.
+//
+// Time complexity: O(E log E) for ranking edges and O(E log V / P)
+// for rounds, where P is GOMAXPROCS.
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Bor%C5%AFvka%27s_algorithm
+func NewBoruvka[V graph.Vertex, W sp.Weight](objective Objective) SpanningForest[V, W] {
+	return boruvka[V, W]{objective}
+}
+
+func (algorithm boruvka[V, W]) Compute(digraph *sp.WeightedSimpleDigraph[V, W]) *Forest[V, W] {
+	assertPreconditions(digraph)
+
+	const noEdge = -1
+
+	ranked := rankedEdges(digraph, algorithm.objective)
+	indexer := graph.NewIndexer(digraph.AllVertices())
+	sources := make([]int, len(ranked))
+	targets := make([]int, len(ranked))
+
+	for i, edge := range ranked {
+		sources[i], _ = indexer.Index(edge.Source())
+		targets[i], _ = indexer.Index(edge.Target())
+	}
+
+	unionFind := connectivity.NewUnionFind[int]()
+	component := make([]int, indexer.Len())
+	edges := make([]graph.Edge[V], 0)
+	amountOfWorkers := max(1, min(runtime.GOMAXPROCS(0), len(ranked)))
+	chunkSize := (len(ranked) + amountOfWorkers - 1) / amountOfWorkers
+	workerBestEdges := make([][]int, amountOfWorkers)
+
+	for worker := range workerBestEdges {
+		workerBestEdges[worker] = make([]int, indexer.Len())
+	}
+
+	for u := range component {
+		unionFind.Add(u)
+	}
+
+	for {
+		for u := range component {
+			component[u] = unionFind.Find(u)
+		}
+
+		// best edges are ranks, so the best is the least
+		var waitGroup sync.WaitGroup
+
+		for worker, bestEdges := range workerBestEdges {
+			waitGroup.Add(1)
+
+			go func() {
+				defer waitGroup.Done()
+
+				for c := range bestEdges {
+					bestEdges[c] = noEdge
+				}
+
+				for i := worker * chunkSize; i < min((worker+1)*chunkSize, len(ranked)); i++ {
+					a := component[sources[i]]
+					b := component[targets[i]]
+
+					if a == b {
+						continue
+					}
+
+					for _, c := range [...]int{a, b} {
+						if bestEdges[c] == noEdge || i < bestEdges[c] {
+							bestEdges[c] = i
+						}
+					}
+				}
+			}()
+		}
+
+		waitGroup.Wait()
+
+		isMerged := false
+
+		for c := range component {
+			best := noEdge
+
+			for _, bestEdges := range workerBestEdges {
+				if bestEdges[c] != noEdge && (best == noEdge || bestEdges[c] < best) {
+					best = bestEdges[c]
+				}
+			}
+
+			// ranks are a strict total order, so best edges never close
+			// a cycle, but two components may choose the same edge
+			if best != noEdge && unionFind.Union(sources[best], targets[best]) {
+				edges = append(edges, ranked[best])
+				isMerged = true
+			}
+		}
+
+		if !isMerged {
+			return newForest(digraph, edges)
+		}
+	}
+}
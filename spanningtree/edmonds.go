@@ -0,0 +1,322 @@
+package spanningtree
+
+import (
+	"goraph/graph"
+	"goraph/graph/traverse"
+	sp "goraph/shortestpath"
+)
+
+// SpanningArborescence interface represents an algorithm with single method
+// that computes a minimum spanning arborescence of a digraph.
+//
+// https://en.wikipedia.org/wiki/Arborescence_(graph_theory)
+type SpanningArborescence[V graph.Vertex, W sp.Weight] interface {
+	// Compute computes a spanning arborescence of digraph rooted at root with
+	// the least total weight: a spanning tree in which every vertex other
+	// than root has exactly one incoming edge and is reachable from root.
+	//
+	// It returns *UnreachableError if some vertex is not reachable from root.
+	//
+	// It panics if digraph, digraph.SimpleDigraph or digraph.Weight is nil
+	// or root is not present in digraph.
+	Compute(digraph *sp.WeightedSimpleDigraph[V, W], root V) (*Forest[V, W], error)
+}
+
+type edmonds[V graph.Vertex, W sp.Weight] struct{}
+
+var _ SpanningArborescence[struct{}, int] = (*edmonds[struct{}, int])(nil)
+
+// NewEdmonds creates an Edmonds' (Chu-Liu/Edmonds) algorithm implementation
+// of SpanningArborescence. Every vertex chooses its cheapest incoming edge,
+// cycles of chosen edges are contracted with incoming edges reweighted
+// and contracted cycles are expanded back in the end.
+//
+// It's Tarjan's efficient implementation: incoming edges of every (contracted)
+// vertex are in a mergeable leftist heap with lazy reweighting and contracted
+// vertices are tracked by union-find with rollback for the expansion.
+//
+// Time complexity: O(E log V).
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Edmonds%27_algorithm
+func NewEdmonds[V graph.Vertex, W sp.Weight]() SpanningArborescence[V, W] {
+	return edmonds[V, W]{}
+}
+
+// arborescenceEdge is an edge between vertex indices.
+type arborescenceEdge[V graph.Vertex] struct {
+	source int
+	target int
+	edge   graph.Edge[V]
+}
+
+// contractedCycle is a cycle contracted into vertex when union-find had
+// history of length time, edges hold chosen edges of the cycle.
+type contractedCycle[V graph.Vertex] struct {
+	vertex int
+	time   int
+	edges  []arborescenceEdge[V]
+}
+
+func (algorithm edmonds[V, W]) Compute(digraph *sp.WeightedSimpleDigraph[V, W], root V) (*Forest[V, W], error) {
+	assertPreconditions(digraph)
+
+	if !digraph.Vertices().Contains(root) {
+		panic("root is not present in digraph")
+	}
+
+	result := traverse.BreadthFirstSearch(digraph, []V{root}, traverse.Visitor[V]{}, traverse.Options{})
+	unreachable := make([]V, 0)
+
+	for vertex := range digraph.AllVertices() {
+		if !result.IsReached(vertex) {
+			unreachable = append(unreachable, vertex)
+		}
+	}
+
+	if len(unreachable) > 0 {
+		return nil, &UnreachableError[V]{Vertices: unreachable}
+	}
+
+	indexer := graph.NewIndexer(digraph.AllVertices())
+	n := indexer.Len()
+	rootIndex, _ := indexer.Index(root)
+	heaps := make([]*leftistHeap[V, W], n)
+
+	for edge := range digraph.AllEdges() {
+		source, _ := indexer.Index(edge.Source())
+		target, _ := indexer.Index(edge.Target())
+
+		if target != rootIndex {
+			node := &leftistHeap[V, W]{key: digraph.Weight[edge], edge: arborescenceEdge[V]{source, target, edge}, rank: 1}
+			heaps[target] = heaps[target].merge(node)
+		}
+	}
+
+	const unseen = -1
+
+	unionFind := newRollbackUnionFind(n)
+	seen := make([]int, n)
+	chosen := make([]arborescenceEdge[V], n)
+	queue := make([]arborescenceEdge[V], n)
+	path := make([]int, n)
+	cycles := make([]contractedCycle[V], 0)
+
+	for i := range seen {
+		seen[i] = unseen
+	}
+
+	seen[rootIndex] = rootIndex
+
+	for s := range n {
+		u := s
+		length := 0
+
+		for seen[u] == unseen {
+			// every vertex is reachable from root, so its heap is not empty
+			key, edge := heaps[u].top()
+			heaps[u].lazy += key
+			heaps[u] = heaps[u].pop()
+
+			queue[length] = edge
+			path[length] = u
+			length++
+			seen[u] = s
+			u = unionFind.find(edge.source)
+
+			if seen[u] != s {
+				continue
+			}
+
+			// contract the cycle of chosen edges through u
+			var cycleHeap *leftistHeap[V, W]
+
+			end := length
+			time := unionFind.time()
+
+			for {
+				length--
+				w := path[length]
+				cycleHeap = cycleHeap.merge(heaps[w])
+
+				if !unionFind.union(u, w) {
+					break
+				}
+			}
+
+			u = unionFind.find(u)
+			heaps[u] = cycleHeap
+			seen[u] = unseen
+			cycles = append(cycles, contractedCycle[V]{u, time, append([]arborescenceEdge[V](nil), queue[length:end]...)})
+		}
+
+		for _, edge := range queue[:length] {
+			chosen[unionFind.find(edge.target)] = edge
+		}
+	}
+
+	// expand cycles in reverse order of contraction: the edge entering
+	// a cycle replaces the cycle edge into the same vertex
+	for i := len(cycles) - 1; i >= 0; i-- {
+		cycle := cycles[i]
+		unionFind.rollback(cycle.time)
+		entering := chosen[cycle.vertex]
+
+		for _, edge := range cycle.edges {
+			chosen[unionFind.find(edge.target)] = edge
+		}
+
+		chosen[unionFind.find(entering.target)] = entering
+	}
+
+	edges := make([]graph.Edge[V], 0, n-1)
+
+	for v, edge := range chosen {
+		if v != rootIndex {
+			edges = append(edges, edge.edge)
+		}
+	}
+
+	return newForest(digraph, edges), nil
+}
+
+// leftistHeap is a min leftist heap of edges by key, which is the weight
+// reduced by lazy of this node and its ancestors.
+type leftistHeap[V graph.Vertex, W sp.Weight] struct {
+	key  W
+	edge arborescenceEdge[V]
+
+	// lazy is subtracted from keys of this node and its descendants.
+	lazy W
+
+	rank  int
+	left  *leftistHeap[V, W]
+	right *leftistHeap[V, W]
+}
+
+// push applies lazy of heap to its key and passes it to children.
+func (heap *leftistHeap[V, W]) push() {
+	var zero W
+
+	if heap.lazy == zero {
+		return
+	}
+
+	heap.key -= heap.lazy
+
+	for _, child := range [...]*leftistHeap[V, W]{heap.left, heap.right} {
+		if child != nil {
+			child.lazy += heap.lazy
+		}
+	}
+
+	heap.lazy = zero
+}
+
+func (heap *leftistHeap[V, W]) rankOf() int {
+	if heap == nil {
+		return 0
+	}
+
+	return heap.rank
+}
+
+// merge merges heap and other destructively, the right spine of a leftist
+// heap has O(log n) nodes, so the recursion is shallow.
+func (heap *leftistHeap[V, W]) merge(other *leftistHeap[V, W]) *leftistHeap[V, W] {
+	if heap == nil {
+		return other
+	}
+	if other == nil {
+		return heap
+	}
+
+	heap.push()
+	other.push()
+
+	if other.key < heap.key {
+		heap, other = other, heap
+	}
+
+	heap.right = heap.right.merge(other)
+
+	if heap.left.rankOf() < heap.right.rankOf() {
+		heap.left, heap.right = heap.right, heap.left
+	}
+
+	heap.rank = heap.right.rankOf() + 1
+
+	return heap
+}
+
+func (heap *leftistHeap[V, W]) top() (W, arborescenceEdge[V]) {
+	heap.push()
+
+	return heap.key, heap.edge
+}
+
+func (heap *leftistHeap[V, W]) pop() *leftistHeap[V, W] {
+	heap.push()
+
+	return heap.left.merge(heap.right)
+}
+
+// rollbackUnionFind is a union-find by size without path compression,
+// so unions can be undone.
+type rollbackUnionFind struct {
+	// parent is the parent of a vertex or the negated size of its set
+	// if the vertex is a root.
+	parent  []int
+	history [][2]int
+}
+
+func newRollbackUnionFind(n int) *rollbackUnionFind {
+	unionFind := &rollbackUnionFind{parent: make([]int, n)}
+
+	for u := range unionFind.parent {
+		unionFind.parent[u] = -1
+	}
+
+	return unionFind
+}
+
+func (unionFind *rollbackUnionFind) find(u int) int {
+	for unionFind.parent[u] >= 0 {
+		u = unionFind.parent[u]
+	}
+
+	return u
+}
+
+func (unionFind *rollbackUnionFind) time() int {
+	return len(unionFind.history)
+}
+
+func (unionFind *rollbackUnionFind) union(u, v int) bool {
+	u = unionFind.find(u)
+	v = unionFind.find(v)
+
+	if u == v {
+		return false
+	}
+
+	if unionFind.parent[u] > unionFind.parent[v] {
+		u, v = v, u
+	}
+
+	unionFind.history = append(unionFind.history, [2]int{u, unionFind.parent[u]}, [2]int{v, unionFind.parent[v]})
+	unionFind.parent[u] += unionFind.parent[v]
+	unionFind.parent[v] = u
+
+	return true
+}
+
+// rollback undoes unions until history has length time.
+func (unionFind *rollbackUnionFind) rollback(time int) {
+	for len(unionFind.history) > time {
+		last := unionFind.history[len(unionFind.history)-1]
+		unionFind.history = unionFind.history[:len(unionFind.history)-1]
+		unionFind.parent[last[0]] = last[1]
+	}
+}
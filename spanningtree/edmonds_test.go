@@ -0,0 +1,196 @@
+package spanningtree
+
+import (
+	"errors"
+	"goraph/graph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/graph/generate"
+	sp "goraph/shortestpath"
+	"slices"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+// bruteForceArborescenceWeight tries every choice of an incoming edge for
+// every vertex other than root and returns the least weight of choices
+// in which every vertex reaches root by chosen edges.
+func bruteForceArborescenceWeight(digraph *sp.WeightedSimpleDigraph[int, int], root int) (int, bool) {
+	vertices := slices.Collect(digraph.AllVertices())
+	incoming := make(map[int][]int)
+	parent := make(map[int]int)
+	best, isFound := 0, false
+
+	for _, vertex := range vertices {
+		incoming[vertex] = slices.Collect(digraph.PredecessorsSeq(vertex))
+	}
+
+	var choose func(i, weight int)
+
+	choose = func(i, weight int) {
+		if i == len(vertices) {
+			for _, vertex := range vertices {
+				for steps := 0; vertex != root; steps++ {
+					if steps == len(vertices) {
+						return
+					}
+
+					vertex = parent[vertex]
+				}
+			}
+
+			if !isFound || weight < best {
+				best, isFound = weight, true
+			}
+
+			return
+		}
+
+		if vertices[i] == root {
+			choose(i+1, weight)
+
+			return
+		}
+
+		for _, u := range incoming[vertices[i]] {
+			parent[vertices[i]] = u
+			choose(i+1, weight+digraph.Weight[graph.NewEdge(u, vertices[i])])
+		}
+	}
+
+	choose(0, 0)
+
+	return best, isFound
+}
+
+func assertIsArborescence(t *testing.T, digraph *sp.WeightedSimpleDigraph[int, int], root int, forest *Forest[int, int]) {
+	assert.Len(t, forest.Edges, digraph.Order()-1)
+
+	parent := make(map[int]int)
+	weight := 0
+
+	for _, edge := range forest.Edges {
+		assert.NotNil(t, digraph.Edge(edge.Source(), edge.Target()))
+		assert.NotContains(t, parent, edge.Target())
+
+		parent[edge.Target()] = edge.Source()
+		weight += digraph.Weight[edge]
+	}
+
+	assert.NotContains(t, parent, root)
+	assert.Equal(t, weight, forest.Weight)
+
+	for vertex := range digraph.AllVertices() {
+		for steps := 0; vertex != root; steps++ {
+			assert.Less(t, steps, digraph.Order())
+
+			vertex = parent[vertex]
+		}
+	}
+}
+
+func TestEdmonds_Compute(t *testing.T) {
+	// 2 and 3 prefer each other, so the cycle 2 -> 3 -> 2 is contracted
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4),
+		mapset.NewFromElements(
+			graph.NewEdge(1, 2),
+			graph.NewEdge(1, 3),
+			graph.NewEdge(2, 3),
+			graph.NewEdge(3, 2),
+			graph.NewEdge(3, 4),
+			graph.NewEdge(4, 2),
+		),
+	)
+	digraph := &sp.WeightedSimpleDigraph[int, int]{
+		SimpleDigraph: simpleDigraph,
+		Weight: sp.Weights[int, int]{
+			graph.NewEdge(1, 2): 10,
+			graph.NewEdge(1, 3): 8,
+			graph.NewEdge(2, 3): 1,
+			graph.NewEdge(3, 2): 1,
+			graph.NewEdge(3, 4): 2,
+			graph.NewEdge(4, 2): 5,
+		},
+	}
+
+	forest, err := NewEdmonds[int, int]().Compute(digraph, 1)
+
+	assert.Nil(t, err)
+	assert.ElementsMatch(
+		t,
+		[]graph.Edge[int]{graph.NewEdge(1, 3), graph.NewEdge(3, 2), graph.NewEdge(3, 4)},
+		forest.Edges,
+	)
+	assert.Equal(t, 11, forest.Weight)
+
+	_, err = NewEdmonds[int, int]().Compute(digraph, 2)
+
+	var unreachableErr *UnreachableError[int]
+
+	assert.True(t, errors.As(err, &unreachableErr))
+	assert.Equal(t, []int{1}, unreachableErr.Vertices)
+
+	assert.Panics(t, func() { _, _ = NewEdmonds[int, int]().Compute(digraph, 5) })
+}
+
+func TestEdmonds_Compute_Random(t *testing.T) {
+	rng := generate.NewRand(1)
+	amountOfArborescences := 0
+
+	for i := range 200 {
+		simpleDigraph, err := generate.ErdosRenyiGnp(6, 0.3+float64(i%3)*0.1, rng)
+
+		assert.Nil(t, err)
+
+		digraph := &sp.WeightedSimpleDigraph[int, int]{SimpleDigraph: simpleDigraph, Weight: sp.Weights[int, int]{}}
+
+		for edge := range simpleDigraph.AllEdges() {
+			digraph.Weight[edge] = rng.IntN(21) - 5
+		}
+
+		root := 1 + i%6
+		expectedWeight, isFound := bruteForceArborescenceWeight(digraph, root)
+		forest, err := NewEdmonds[int, int]().Compute(digraph, root)
+
+		if !isFound {
+			var unreachableErr *UnreachableError[int]
+
+			assert.True(t, errors.As(err, &unreachableErr))
+			assert.NotEmpty(t, unreachableErr.Vertices)
+
+			continue
+		}
+
+		amountOfArborescences++
+
+		assert.Nil(t, err)
+		assertIsArborescence(t, digraph, root, forest)
+		assert.Equal(t, expectedWeight, forest.Weight)
+	}
+
+	assert.Greater(t, amountOfArborescences, 50)
+}
+
+func TestEdmonds_Compute_Unsigned(t *testing.T) {
+	simpleDigraph, err := generate.Complete(5)
+
+	assert.Nil(t, err)
+
+	digraph := &sp.WeightedSimpleDigraph[int, uint16]{SimpleDigraph: simpleDigraph, Weight: sp.Weights[int, uint16]{}}
+
+	for edge := range simpleDigraph.AllEdges() {
+		// going from a lesser vertex to a greater one is cheap
+		if edge.Source() < edge.Target() {
+			digraph.Weight[edge] = uint16(edge.Target() - edge.Source())
+		} else {
+			digraph.Weight[edge] = 100
+		}
+	}
+
+	forest, err := NewEdmonds[int, uint16]().Compute(digraph, 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, uint16(4), forest.Weight)
+}
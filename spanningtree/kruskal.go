@@ -0,0 +1,40 @@
+package spanningtree
+
+import (
+	"goraph/connectivity"
+	"goraph/graph"
+	sp "goraph/shortestpath"
+)
+
+type kruskal[V graph.Vertex, W sp.Weight] struct {
+	objective Objective
+}
+
+var _ SpanningForest[struct{}, int] = (*kruskal[struct{}, int])(nil)
+
+// NewKruskal creates a Kruskal's algorithm implementation of SpanningForest,
+// which adds edges from the best to the worst unless they close a cycle.
+//
+// Time complexity: O(E log E).
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Kruskal%27s_algorithm
+func NewKruskal[V graph.Vertex, W sp.Weight](objective Objective) SpanningForest[V, W] {
+	return kruskal[V, W]{objective}
+}
+
+func (algorithm kruskal[V, W]) Compute(digraph *sp.WeightedSimpleDigraph[V, W]) *Forest[V, W] {
+	assertPreconditions(digraph)
+
+	unionFind := connectivity.NewUnionFind[V]()
+	edges := make([]graph.Edge[V], 0)
+
+	for _, edge := range rankedEdges(digraph, algorithm.objective) {
+		if unionFind.Union(edge.Source(), edge.Target()) {
+			edges = append(edges, edge)
+		}
+	}
+
+	return newForest(digraph, edges)
+}
@@ -0,0 +1,84 @@
+package spanningtree
+
+import (
+	"goraph/graph"
+	"goraph/internal/priorityqueue"
+	sp "goraph/shortestpath"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+)
+
+type prim[V graph.Vertex, W sp.Weight] struct {
+	objective Objective
+}
+
+var _ SpanningForest[struct{}, int] = (*prim[struct{}, int])(nil)
+
+// NewPrim creates a Prim's algorithm implementation of SpanningForest,
+// which grows a tree from every component by the best edge leaving it.
+//
+// It uses an indexed binary heap with decrease-key of vertices by ranks
+// of their best edges, so it works the same for any Weight and Objective.
+//
+// Time complexity: O(E log E).
+//
+// This implementation is immutable and thread-safe.
+//
+// https://en.wikipedia.org/wiki/Prim%27s_algorithm
+func NewPrim[V graph.Vertex, W sp.Weight](objective Objective) SpanningForest[V, W] {
+	return prim[V, W]{objective}
+}
+
+func (algorithm prim[V, W]) Compute(digraph *sp.WeightedSimpleDigraph[V, W]) *Forest[V, W] {
+	assertPreconditions(digraph)
+
+	ranked := rankedEdges(digraph, algorithm.objective)
+	rank := make(map[graph.Edge[V]]int, len(ranked))
+
+	for i, edge := range ranked {
+		rank[edge] = i
+	}
+
+	isInTree := mapset.New[V]()
+	bestEdge := make(map[V]graph.Edge[V])
+	edges := make([]graph.Edge[V], 0)
+
+	for root := range digraph.AllVertices() {
+		if isInTree.Contains(root) {
+			continue
+		}
+
+		vertexQueue := priorityqueue.NewBinaryHeap[V, int]()
+		vertexQueue.Push(root, -1)
+
+		for !vertexQueue.IsEmpty() {
+			u, _ := vertexQueue.Pop()
+			isInTree.Add(u)
+
+			if u != root {
+				edges = append(edges, bestEdge[u])
+			}
+
+			relax := func(edge graph.Edge[V], v V) {
+				if isInTree.Contains(v) {
+					return
+				}
+
+				if best, isPresent := bestEdge[v]; !isPresent || rank[edge] < rank[best] {
+					bestEdge[v] = edge
+					vertexQueue.Push(v, rank[edge])
+				}
+			}
+
+			for v := range digraph.SuccessorsSeq(u) {
+				relax(graph.NewEdge(u, v), v)
+			}
+
+			for v := range digraph.PredecessorsSeq(u) {
+				relax(graph.NewEdge(v, u), v)
+			}
+		}
+	}
+
+	return newForest(digraph, edges)
+}
@@ -0,0 +1,94 @@
+// Package spanningtree provides minimum and maximum spanning forests
+// of weighted undirected graphs.
+//
+// An undirected graph is expected as shortestpath.WeightedSimpleDigraph, which
+// underlying undirected graph is described in package digraph, so (u, v) and
+// (v, u) are a single undirected edge and at most one of them is chosen:
+// the lighter one for Minimize and the heavier one for Maximize.
+//
+// https://en.wikipedia.org/wiki/Minimum_spanning_tree
+package spanningtree
+
+import (
+	"cmp"
+	"goraph/graph"
+	sp "goraph/shortestpath"
+	"slices"
+)
+
+// Objective defines whether the total weight of a spanning forest
+// is minimized or maximized.
+type Objective int
+
+const (
+	Minimize Objective = iota
+	Maximize
+)
+
+// SpanningForest interface represents an algorithm with single method
+// that computes a spanning forest of an undirected graph.
+type SpanningForest[V graph.Vertex, W sp.Weight] interface {
+	// Compute computes Forest of digraph with the least (or the greatest)
+	// total weight: a spanning tree of every connected component.
+	//
+	// If some edges have equal weight, there may be several such forests
+	// and any of them is returned.
+	//
+	// It panics if digraph, digraph.SimpleDigraph or digraph.Weight is nil.
+	Compute(digraph *sp.WeightedSimpleDigraph[V, W]) *Forest[V, W]
+}
+
+// Forest is a spanning forest.
+type Forest[V graph.Vertex, W sp.Weight] struct {
+	// Edges holds edges of the forest as they are present in the digraph.
+	Edges []graph.Edge[V]
+
+	// Weight is the total weight of Edges.
+	Weight W
+}
+
+// newForest creates Forest with edges.
+func newForest[V graph.Vertex, W sp.Weight](
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	edges []graph.Edge[V],
+) *Forest[V, W] {
+	forest := &Forest[V, W]{Edges: edges}
+
+	for _, edge := range edges {
+		forest.Weight += digraph.Weight[edge]
+	}
+
+	return forest
+}
+
+// rankedEdges returns edges of digraph from the best to the worst by objective,
+// so ranks (indices) of edges are a strict total order. Edges of equal weight
+// are ranked by the iteration order of digraph, which may differ between calls.
+func rankedEdges[V graph.Vertex, W sp.Weight](
+	digraph *sp.WeightedSimpleDigraph[V, W],
+	objective Objective,
+) []graph.Edge[V] {
+	edges := slices.Collect(digraph.AllEdges())
+
+	slices.SortStableFunc(edges, func(uv, xy graph.Edge[V]) int {
+		if objective == Maximize {
+			return cmp.Compare(digraph.Weight[xy], digraph.Weight[uv])
+		}
+
+		return cmp.Compare(digraph.Weight[uv], digraph.Weight[xy])
+	})
+
+	return edges
+}
+
+func assertPreconditions[V graph.Vertex, W sp.Weight](digraph *sp.WeightedSimpleDigraph[V, W]) {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+	if digraph.SimpleDigraph == nil {
+		panic("digraph.SimpleDigraph == nil")
+	}
+	if digraph.Weight == nil {
+		panic("digraph.Weight == nil")
+	}
+}
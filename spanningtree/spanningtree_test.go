@@ -0,0 +1,193 @@
+package spanningtree
+
+import (
+	"fmt"
+	"goraph/connectivity"
+	"goraph/graph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/graph/generate"
+	sp "goraph/shortestpath"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAlgorithms(objective Objective) map[string]SpanningForest[int, int] {
+	return map[string]SpanningForest[int, int]{
+		"Kruskal": NewKruskal[int, int](objective),
+		"Prim":    NewPrim[int, int](objective),
+		"Boruvka": NewBoruvka[int, int](objective),
+	}
+}
+
+// worstOnTreePath returns the worst weight by objective on the path
+// between u and v in forest or false if there is no such path.
+func worstOnTreePath(
+	digraph *sp.WeightedSimpleDigraph[int, int],
+	forest *Forest[int, int],
+	objective Objective,
+	u, v int,
+) (int, bool) {
+	adjacent := make(map[int][]graph.Edge[int])
+
+	for _, edge := range forest.Edges {
+		adjacent[edge.Source()] = append(adjacent[edge.Source()], edge)
+		adjacent[edge.Target()] = append(adjacent[edge.Target()], edge)
+	}
+
+	type state struct {
+		vertex int
+		worst  int
+		isSet  bool
+	}
+
+	isVisited := map[int]bool{u: true}
+	stack := []state{{vertex: u}}
+
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if top.vertex == v {
+			return top.worst, true
+		}
+
+		for _, edge := range adjacent[top.vertex] {
+			next := edge.Source() + edge.Target() - top.vertex
+
+			if isVisited[next] {
+				continue
+			}
+
+			isVisited[next] = true
+			weight := digraph.Weight[edge]
+			worst := top.worst
+
+			if !top.isSet || (objective == Minimize && weight > worst) || (objective == Maximize && weight < worst) {
+				worst = weight
+			}
+
+			stack = append(stack, state{next, worst, true})
+		}
+	}
+
+	return 0, false
+}
+
+func TestSpanningForest_Compute(t *testing.T) {
+	// square 1-2-3-4 with diagonal 1-3, both directions of 3-4,
+	// and separate edge 5-6
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4, 5, 6, 7),
+		mapset.NewFromElements(
+			graph.NewEdge(1, 2),
+			graph.NewEdge(3, 2),
+			graph.NewEdge(3, 4),
+			graph.NewEdge(4, 3),
+			graph.NewEdge(4, 1),
+			graph.NewEdge(1, 3),
+			graph.NewEdge(5, 6),
+		),
+	)
+	digraph := &sp.WeightedSimpleDigraph[int, int]{
+		SimpleDigraph: simpleDigraph,
+		Weight: sp.Weights[int, int]{
+			graph.NewEdge(1, 2): 1,
+			graph.NewEdge(3, 2): 2,
+			graph.NewEdge(3, 4): 5,
+			graph.NewEdge(4, 3): 3,
+			graph.NewEdge(4, 1): 4,
+			graph.NewEdge(1, 3): 6,
+			graph.NewEdge(5, 6): -1,
+		},
+	}
+
+	for name, algorithm := range newAlgorithms(Minimize) {
+		t.Run(name, func(t *testing.T) {
+			forest := algorithm.Compute(digraph)
+
+			assert.ElementsMatch(
+				t,
+				[]graph.Edge[int]{graph.NewEdge(1, 2), graph.NewEdge(3, 2), graph.NewEdge(4, 3), graph.NewEdge(5, 6)},
+				forest.Edges,
+			)
+			assert.Equal(t, 5, forest.Weight)
+		})
+	}
+
+	for name, algorithm := range newAlgorithms(Maximize) {
+		t.Run("Maximum"+name, func(t *testing.T) {
+			forest := algorithm.Compute(digraph)
+
+			assert.ElementsMatch(
+				t,
+				[]graph.Edge[int]{graph.NewEdge(1, 3), graph.NewEdge(3, 4), graph.NewEdge(3, 2), graph.NewEdge(5, 6)},
+				forest.Edges,
+			)
+			assert.Equal(t, 12, forest.Weight)
+		})
+	}
+}
+
+func TestSpanningForest_Compute_Random(t *testing.T) {
+	rng := generate.NewRand(1)
+
+	for i := range 30 {
+		simpleDigraph, err := generate.ErdosRenyiGnp(40, 0.02+float64(i%3)*0.04, rng)
+
+		assert.Nil(t, err)
+
+		digraph := &sp.WeightedSimpleDigraph[int, int]{SimpleDigraph: simpleDigraph, Weight: sp.Weights[int, int]{}}
+
+		for edge := range simpleDigraph.AllEdges() {
+			digraph.Weight[edge] = rng.IntN(10)
+		}
+
+		amountOfComponents := connectivity.WeaklyConnectedComponents(simpleDigraph).Count()
+
+		for _, objective := range []Objective{Minimize, Maximize} {
+			expectedWeight := NewKruskal[int, int](objective).Compute(digraph).Weight
+
+			// forests differ when weights are equal, so every forest is
+			// checked on its own
+			for name, algorithm := range newAlgorithms(objective) {
+				forest := algorithm.Compute(digraph)
+				message := fmt.Sprint(name, objective)
+
+				assert.Len(t, forest.Edges, simpleDigraph.Order()-amountOfComponents, message)
+				assert.Equal(t, expectedWeight, forest.Weight, message)
+
+				// cycle property: every edge out of the forest is not better than
+				// every forest edge on the path between its endpoints
+				for edge := range simpleDigraph.AllEdges() {
+					worst, isConnected := worstOnTreePath(digraph, forest, objective, edge.Source(), edge.Target())
+
+					assert.True(t, isConnected, message)
+
+					if objective == Minimize {
+						assert.GreaterOrEqual(t, digraph.Weight[edge], worst, message)
+					} else {
+						assert.LessOrEqual(t, digraph.Weight[edge], worst, message)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSpanningForest_Compute_Unsigned(t *testing.T) {
+	simpleDigraph, err := generate.Complete(4)
+
+	assert.Nil(t, err)
+
+	digraph := &sp.WeightedSimpleDigraph[int, uint8]{SimpleDigraph: simpleDigraph, Weight: sp.Weights[int, uint8]{}}
+
+	for edge := range simpleDigraph.AllEdges() {
+		digraph.Weight[edge] = uint8(edge.Source() * edge.Target())
+	}
+
+	assert.Equal(t, uint8(2+3+4), NewPrim[int, uint8](Minimize).Compute(digraph).Weight)
+	assert.Equal(t, uint8(12+8+4), NewPrim[int, uint8](Maximize).Compute(digraph).Weight)
+	assert.Panics(t, func() { NewBoruvka[int, uint8](Minimize).Compute(nil) })
+}
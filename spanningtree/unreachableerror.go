@@ -0,0 +1,18 @@
+package spanningtree
+
+import (
+	"fmt"
+	"goraph/graph"
+)
+
+// UnreachableError is returned when there is no spanning arborescence
+// because some vertices are not reachable from the root.
+type UnreachableError[V graph.Vertex] struct {
+	// Vertices holds all vertices that are not reachable from the root
+	// in unspecified order.
+	Vertices []V
+}
+
+func (err *UnreachableError[V]) Error() string {
+	return fmt.Sprintf("vertices %v are not reachable from the root", err.Vertices)
+}
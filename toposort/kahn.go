@@ -0,0 +1,150 @@
+package toposort
+
+import (
+	"container/heap"
+	"goraph/graph"
+	dg "goraph/graph/digraph"
+)
+
+// Kahn returns vertices of digraph in topological order computed by
+// Kahn's algorithm: every edge goes from an earlier vertex to a later one.
+//
+// Vertices that become ready are ordered first-in-first-out. Vertices that
+// become ready at the same time are ordered by options.TieBreak if it's
+// not nil or by the iteration order of digraph otherwise.
+//
+// It returns *CycleError with one cycle if digraph is not a DAG.
+//
+// It panics if digraph is nil.
+//
+// Time complexity: O(V + E) or O(V log V + E) with options.TieBreak.
+//
+// https://en.wikipedia.org/wiki/Topological_sorting#Kahn's_algorithm
+func Kahn[V graph.Vertex](digraph dg.Digraph[V], options Options[V]) ([]V, error) {
+	assertDigraphIsNotNil(digraph)
+
+	indexedDigraph := dg.NewIndexedDigraph(digraph)
+	indexer := indexedDigraph.Indexer()
+	inDegree := inDegrees(indexedDigraph)
+	queue := make([]int, 0, indexedDigraph.Order())
+
+	for u, degree := range inDegree {
+		if degree == 0 {
+			queue = append(queue, u)
+		}
+	}
+
+	sortIndices(indexer, queue, options.TieBreak)
+
+	for head := 0; head < len(queue); head++ {
+		u := queue[head]
+		tail := len(queue)
+
+		for _, v := range indexedDigraph.Successors(u) {
+			inDegree[v]--
+
+			if inDegree[v] == 0 {
+				queue = append(queue, v)
+			}
+		}
+
+		sortIndices(indexer, queue[tail:], options.TieBreak)
+	}
+
+	if len(queue) < indexedDigraph.Order() {
+		return nil, cycleError(indexedDigraph, inDegree, options.TieBreak)
+	}
+
+	order := make([]V, len(queue))
+
+	for i, u := range queue {
+		order[i] = indexer.Vertex(u)
+	}
+
+	return order, nil
+}
+
+// LexicographicallySmallest returns the lexicographically smallest
+// topological order of digraph by compare: it's Kahn's algorithm that always
+// takes the least ready vertex.
+//
+// It returns *CycleError with one cycle if digraph is not a DAG.
+//
+// It panics if digraph or compare is nil.
+//
+// Time complexity: O((V + E) log V).
+func LexicographicallySmallest[V graph.Vertex](
+	digraph dg.Digraph[V],
+	compare func(u, v V) int,
+) ([]V, error) {
+	assertDigraphIsNotNil(digraph)
+
+	if compare == nil {
+		panic("compare == nil")
+	}
+
+	indexedDigraph := dg.NewIndexedDigraph(digraph)
+	indexer := indexedDigraph.Indexer()
+	inDegree := inDegrees(indexedDigraph)
+	ready := &indexHeap[V]{indexer: indexer, compare: compare}
+
+	for u, degree := range inDegree {
+		if degree == 0 {
+			ready.indices = append(ready.indices, u)
+		}
+	}
+
+	heap.Init(ready)
+
+	order := make([]V, 0, indexedDigraph.Order())
+
+	for ready.Len() > 0 {
+		u := heap.Pop(ready).(int)
+		order = append(order, indexer.Vertex(u))
+
+		for _, v := range indexedDigraph.Successors(u) {
+			inDegree[v]--
+
+			if inDegree[v] == 0 {
+				heap.Push(ready, v)
+			}
+		}
+	}
+
+	if len(order) < indexedDigraph.Order() {
+		return nil, cycleError(indexedDigraph, inDegree, compare)
+	}
+
+	return order, nil
+}
+
+// indexHeap is a heap.Interface of vertex indices ordered by compare
+// of their vertices.
+type indexHeap[V graph.Vertex] struct {
+	indices []int
+	indexer *graph.Indexer[V]
+	compare func(u, v V) int
+}
+
+func (h *indexHeap[V]) Len() int {
+	return len(h.indices)
+}
+
+func (h *indexHeap[V]) Less(i, j int) bool {
+	return h.compare(h.indexer.Vertex(h.indices[i]), h.indexer.Vertex(h.indices[j])) < 0
+}
+
+func (h *indexHeap[V]) Swap(i, j int) {
+	h.indices[i], h.indices[j] = h.indices[j], h.indices[i]
+}
+
+func (h *indexHeap[V]) Push(x any) {
+	h.indices = append(h.indices, x.(int))
+}
+
+func (h *indexHeap[V]) Pop() any {
+	last := h.indices[len(h.indices)-1]
+	h.indices = h.indices[:len(h.indices)-1]
+
+	return last
+}
@@ -0,0 +1,68 @@
+package toposort
+
+import (
+	"goraph/graph"
+	dg "goraph/graph/digraph"
+)
+
+// Layers returns vertices of digraph grouped by depth: layer 0 holds
+// vertices without predecessors and layer i holds vertices whose longest
+// path from some vertex of layer 0 has i edges.
+//
+// Every edge goes from a lesser layer to a greater one, so vertices of one
+// layer are independent of each other and may be processed in parallel once
+// all previous layers are processed. Vertices of every layer are ordered by
+// options.TieBreak if it's not nil or by the iteration order of digraph
+// otherwise.
+//
+// It returns *CycleError with one cycle if digraph is not a DAG.
+//
+// It panics if digraph is nil.
+//
+// Time complexity: O(V + E) or O(V log V + E) with options.TieBreak.
+func Layers[V graph.Vertex](digraph dg.Digraph[V], options Options[V]) ([][]V, error) {
+	assertDigraphIsNotNil(digraph)
+
+	indexedDigraph := dg.NewIndexedDigraph(digraph)
+	indexer := indexedDigraph.Indexer()
+	inDegree := inDegrees(indexedDigraph)
+	layer := make([]int, 0)
+	amountOfVisitedVertices := 0
+
+	for u, degree := range inDegree {
+		if degree == 0 {
+			layer = append(layer, u)
+		}
+	}
+
+	layers := make([][]V, 0)
+
+	for len(layer) > 0 {
+		sortIndices(indexer, layer, options.TieBreak)
+
+		vertices := make([]V, len(layer))
+		nextLayer := make([]int, 0)
+
+		for i, u := range layer {
+			vertices[i] = indexer.Vertex(u)
+
+			for _, v := range indexedDigraph.Successors(u) {
+				inDegree[v]--
+
+				if inDegree[v] == 0 {
+					nextLayer = append(nextLayer, v)
+				}
+			}
+		}
+
+		layers = append(layers, vertices)
+		amountOfVisitedVertices += len(layer)
+		layer = nextLayer
+	}
+
+	if amountOfVisitedVertices < indexedDigraph.Order() {
+		return nil, cycleError(indexedDigraph, inDegree, options.TieBreak)
+	}
+
+	return layers, nil
+}
@@ -0,0 +1,210 @@
+// Package toposort provides topological ordering of digraph.Digraph
+// and cycle detection with cycle reporting.
+//
+// https://en.wikipedia.org/wiki/Topological_sorting
+package toposort
+
+import (
+	"fmt"
+	"goraph/graph"
+	dg "goraph/graph/digraph"
+	"slices"
+	"strings"
+)
+
+// Options configures Kahn and Layers.
+type Options[V graph.Vertex] struct {
+	// TieBreak, if not nil, compares vertices that become ready at the same
+	// time, lesser vertices are ordered first. It makes the result independent
+	// of the iteration order of the digraph.
+	//
+	// It must be a strict weak ordering like in slices.SortFunc.
+	TieBreak func(u, v V) int
+}
+
+// CycleError is returned when digraph is not a DAG.
+type CycleError[V graph.Vertex] struct {
+	// Cycle holds edges of one cycle in order, the target of the last edge
+	// is the source of the first one.
+	Cycle []graph.Edge[V]
+}
+
+func (err *CycleError[V]) Error() string {
+	var builder strings.Builder
+
+	builder.WriteString("cycle ")
+
+	for _, edge := range err.Cycle {
+		fmt.Fprintf(&builder, "%v -> ", edge.Source())
+	}
+
+	if len(err.Cycle) > 0 {
+		fmt.Fprintf(&builder, "%v", err.Cycle[0].Source())
+	}
+
+	return builder.String()
+}
+
+// Vertices returns vertices of the cycle in order without repeating
+// the first one.
+func (err *CycleError[V]) Vertices() []V {
+	vertices := make([]V, 0, len(err.Cycle))
+
+	for _, edge := range err.Cycle {
+		vertices = append(vertices, edge.Source())
+	}
+
+	return vertices
+}
+
+// IsDAG returns true iff digraph has no cycles.
+//
+// It panics if digraph is nil.
+func IsDAG[V graph.Vertex](digraph dg.Digraph[V]) bool {
+	return FindCycle(digraph) == nil
+}
+
+// FindCycle returns edges of one cycle of digraph in order or nil
+// if digraph is a DAG.
+//
+// It panics if digraph is nil.
+func FindCycle[V graph.Vertex](digraph dg.Digraph[V]) []graph.Edge[V] {
+	assertDigraphIsNotNil(digraph)
+
+	indexedDigraph := dg.NewIndexedDigraph(digraph)
+	inDegree := inDegrees(indexedDigraph)
+	ready := make([]int, 0)
+
+	for u, degree := range inDegree {
+		if degree == 0 {
+			ready = append(ready, u)
+		}
+	}
+
+	for len(ready) > 0 {
+		u := ready[len(ready)-1]
+		ready = ready[:len(ready)-1]
+
+		for _, v := range indexedDigraph.Successors(u) {
+			inDegree[v]--
+
+			if inDegree[v] == 0 {
+				ready = append(ready, v)
+			}
+		}
+	}
+
+	if err := cycleError(indexedDigraph, inDegree, nil); err != nil {
+		return err.Cycle
+	}
+
+	return nil
+}
+
+// inDegrees returns in-degrees of all vertices of indexedDigraph.
+func inDegrees[V graph.Vertex](indexedDigraph *dg.IndexedDigraph[V]) []int {
+	inDegree := make([]int, indexedDigraph.Order())
+
+	for v := range inDegree {
+		inDegree[v] = len(indexedDigraph.Predecessors(v))
+	}
+
+	return inDegree
+}
+
+// cycleError returns CycleError with a cycle among vertices with positive
+// inDegree left after Kahn's algorithm or nil if there are no such vertices.
+//
+// Every such vertex has a predecessor that is also such a vertex, so walking
+// backwards from any of them must eventually repeat a vertex. If tieBreak
+// is not nil, the walk starts from the least vertex and the cycle is rotated
+// to start from its least vertex, so the reported cycle doesn't depend on
+// the iteration order of the digraph.
+func cycleError[V graph.Vertex](
+	indexedDigraph *dg.IndexedDigraph[V],
+	inDegree []int,
+	tieBreak func(u, v V) int,
+) *CycleError[V] {
+	const notOnWalk = -1
+
+	indexer := indexedDigraph.Indexer()
+	start := notOnWalk
+
+	for v, degree := range inDegree {
+		if degree == 0 {
+			continue
+		}
+
+		if start == notOnWalk || (tieBreak != nil && tieBreak(indexer.Vertex(v), indexer.Vertex(start)) < 0) {
+			start = v
+		}
+
+		if tieBreak == nil {
+			break
+		}
+	}
+
+	if start == notOnWalk {
+		return nil
+	}
+
+	positionOnWalk := make(map[int]int)
+	walk := make([]int, 0)
+	v := start
+
+	for {
+		if _, isOnWalk := positionOnWalk[v]; isOnWalk {
+			break
+		}
+
+		positionOnWalk[v] = len(walk)
+		walk = append(walk, v)
+
+		for _, u := range indexedDigraph.Predecessors(v) {
+			if inDegree[u] > 0 {
+				v = u
+
+				break
+			}
+		}
+	}
+
+	// walk goes against edges, so the cycle is reversed
+	cycleVertices := walk[positionOnWalk[v]:]
+	slices.Reverse(cycleVertices)
+
+	if tieBreak != nil {
+		least := slices.MinFunc(cycleVertices, func(u, v int) int {
+			return tieBreak(indexer.Vertex(u), indexer.Vertex(v))
+		})
+		leastPosition := slices.Index(cycleVertices, least)
+		cycleVertices = slices.Concat(cycleVertices[leastPosition:], cycleVertices[:leastPosition])
+	}
+
+	cycle := make([]graph.Edge[V], len(cycleVertices))
+
+	for i, u := range cycleVertices {
+		target := cycleVertices[(i+1)%len(cycleVertices)]
+
+		cycle[i] = graph.NewEdge(indexer.Vertex(u), indexer.Vertex(target))
+	}
+
+	return &CycleError[V]{Cycle: cycle}
+}
+
+// sortIndices sorts indices of vertices by tieBreak if it's not nil.
+func sortIndices[V graph.Vertex](indexer *graph.Indexer[V], indices []int, tieBreak func(u, v V) int) {
+	if tieBreak == nil {
+		return
+	}
+
+	slices.SortFunc(indices, func(u, v int) int {
+		return tieBreak(indexer.Vertex(u), indexer.Vertex(v))
+	})
+}
+
+func assertDigraphIsNotNil[V graph.Vertex](digraph dg.Digraph[V]) {
+	if digraph == nil {
+		panic("digraph == nil")
+	}
+}
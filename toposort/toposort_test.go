@@ -0,0 +1,200 @@
+package toposort
+
+import (
+	"cmp"
+	"errors"
+	"goraph/graph"
+	"goraph/graph/digraph"
+	al "goraph/graph/digraph/simpledigraph/adjacencylist"
+	"goraph/graph/generate"
+	"testing"
+
+	"github.com/nikolai-kramskoy/go-data-structures/set/mapset"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPipeline(t *testing.T) digraph.Digraph[string] {
+	simpleDigraph, err := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements("fetch", "lint", "build", "test", "package", "deploy", "docs"),
+		mapset.NewFromElements(
+			graph.NewEdge("fetch", "lint"),
+			graph.NewEdge("fetch", "build"),
+			graph.NewEdge("build", "test"),
+			graph.NewEdge("lint", "package"),
+			graph.NewEdge("test", "package"),
+			graph.NewEdge("package", "deploy"),
+		),
+	)
+
+	assert.NoError(t, err)
+
+	return simpleDigraph
+}
+
+func assertIsTopologicalOrder[V graph.Vertex](t *testing.T, digraph digraph.Digraph[V], order []V) {
+	assert.Len(t, order, digraph.Order())
+
+	position := make(map[V]int, len(order))
+
+	for i, vertex := range order {
+		position[vertex] = i
+	}
+
+	for edge := range digraph.AllEdges() {
+		assert.Less(t, position[edge.Source()], position[edge.Target()])
+	}
+}
+
+func assertIsCycle[V graph.Vertex](t *testing.T, digraph digraph.Digraph[V], cycle []graph.Edge[V]) {
+	assert.NotEmpty(t, cycle)
+
+	for i, edge := range cycle {
+		assert.True(t, digraph.Edges().Contains(edge))
+		assert.Equal(t, edge.Target(), cycle[(i+1)%len(cycle)].Source())
+	}
+}
+
+func TestKahn(t *testing.T) {
+	pipeline := newPipeline(t)
+
+	order, err := Kahn(pipeline, Options[string]{})
+
+	assert.NoError(t, err)
+	assertIsTopologicalOrder(t, pipeline, order)
+
+	order, err = Kahn(pipeline, Options[string]{TieBreak: cmp.Compare[string]})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"docs", "fetch", "build", "lint", "test", "package", "deploy"}, order)
+}
+
+func TestLexicographicallySmallest(t *testing.T) {
+	pipeline := newPipeline(t)
+
+	order, err := LexicographicallySmallest(pipeline, cmp.Compare[string])
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"docs", "fetch", "build", "lint", "test", "package", "deploy"}, order)
+
+	// Kahn with TieBreak is not lexicographically smallest here
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements(1, 2, 3, 4),
+		mapset.NewFromElements(graph.NewEdge(3, 1), graph.NewEdge(4, 2)),
+	)
+
+	order2, _ := LexicographicallySmallest(simpleDigraph, cmp.Compare[int])
+	kahnOrder, _ := Kahn(simpleDigraph, Options[int]{TieBreak: cmp.Compare[int]})
+
+	assert.Equal(t, []int{3, 1, 4, 2}, order2)
+	assert.Equal(t, []int{3, 4, 1, 2}, kahnOrder)
+
+	assert.Panics(t, func() { _, _ = LexicographicallySmallest(simpleDigraph, nil) })
+}
+
+func TestLayers(t *testing.T) {
+	layers, err := Layers(newPipeline(t), Options[string]{TieBreak: cmp.Compare[string]})
+
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[][]string{{"docs", "fetch"}, {"build", "lint"}, {"test"}, {"package"}, {"deploy"}},
+		layers,
+	)
+}
+
+func TestCycleError(t *testing.T) {
+	simpleDigraph, _ := al.NewAdjacencyListSimpleDigraph(
+		mapset.NewFromElements("a", "b", "c", "d", "e"),
+		mapset.NewFromElements(
+			graph.NewEdge("e", "a"),
+			graph.NewEdge("a", "b"),
+			graph.NewEdge("b", "c"),
+			graph.NewEdge("c", "a"),
+			graph.NewEdge("c", "d"),
+		),
+	)
+
+	_, kahnErr := Kahn(simpleDigraph, Options[string]{TieBreak: cmp.Compare[string]})
+	_, lexicographicallySmallestErr := LexicographicallySmallest(simpleDigraph, cmp.Compare[string])
+	_, layersErr := Layers(simpleDigraph, Options[string]{})
+
+	for _, err := range []error{kahnErr, lexicographicallySmallestErr, layersErr} {
+		var cycleErr *CycleError[string]
+
+		assert.True(t, errors.As(err, &cycleErr))
+		assertIsCycle(t, simpleDigraph, cycleErr.Cycle)
+		assert.ElementsMatch(t, []string{"a", "b", "c"}, cycleErr.Vertices())
+	}
+
+	var cycleErr *CycleError[string]
+
+	errors.As(kahnErr, &cycleErr)
+
+	assert.Equal(t, []string{"a", "b", "c"}, cycleErr.Vertices())
+	assert.Equal(t, "cycle a -> b -> c -> a", cycleErr.Error())
+
+	assert.False(t, IsDAG(simpleDigraph))
+	assert.True(t, IsDAG(newPipeline(t)))
+	assert.Nil(t, FindCycle(newPipeline(t)))
+}
+
+func TestKahn_Random(t *testing.T) {
+	rng := generate.NewRand(1)
+
+	for range 50 {
+		dag, err := generate.RandomDAG(30, 0.1, rng)
+
+		assert.NoError(t, err)
+
+		order, err := Kahn(dag, Options[int]{})
+
+		assert.NoError(t, err)
+		assertIsTopologicalOrder(t, dag, order)
+
+		layers, err := Layers(dag, Options[int]{})
+
+		assert.NoError(t, err)
+
+		layerOf := make(map[int]int)
+
+		for i, layer := range layers {
+			for _, vertex := range layer {
+				layerOf[vertex] = i
+			}
+		}
+
+		assert.Len(t, layerOf, dag.Order())
+
+		for vertex, i := range layerOf {
+			// every vertex of a non-zero layer has a predecessor in the previous layer
+			if i == 0 {
+				assert.Zero(t, dag.InDegree(vertex))
+
+				continue
+			}
+
+			hasPredecessorInPreviousLayer := false
+
+			for predecessor := range dag.PredecessorsSeq(vertex) {
+				assert.Less(t, layerOf[predecessor], i)
+
+				hasPredecessorInPreviousLayer = hasPredecessorInPreviousLayer || layerOf[predecessor] == i-1
+			}
+
+			assert.True(t, hasPredecessorInPreviousLayer)
+		}
+
+		simpleDigraph, err := generate.ErdosRenyiGnp(15, 0.1, rng)
+
+		assert.NoError(t, err)
+
+		_, err = Kahn(simpleDigraph, Options[int]{})
+		cycle := FindCycle(simpleDigraph)
+
+		if err == nil {
+			assert.Nil(t, cycle)
+		} else {
+			assertIsCycle(t, simpleDigraph, cycle)
+		}
+	}
+}